@@ -0,0 +1,69 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+
+	"github.com/urfave/cli/v3"
+)
+
+func CmdAPIKey() *cli.Command {
+	return &cli.Command{
+		Name:      "apikey",
+		Usage:     "Generate an API key and print its keys file entry",
+		ArgsUsage: "<id>",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "scope",
+				Value: []string{string(auth.ScopeLookup)},
+				Usage: "scopes granted: lookup, networks or admin",
+			},
+			&cli.FloatFlag{
+				Name:  "rate",
+				Usage: "requests per second, 0 for unlimited",
+			},
+			&cli.IntFlag{
+				Name:  "burst",
+				Usage: "requests allowed at once (default: rate rounded up)",
+			},
+		},
+		Action: action,
+	}
+}
+
+func action(_ context.Context, c *cli.Command) error {
+	id := strings.TrimSpace(c.Args().First())
+	if id == "" {
+		return errors.New("key id is required")
+	}
+	for _, s := range c.StringSlice("scope") {
+		if _, ok := auth.ParseScope(s); !ok {
+			return fmt.Errorf("unknown scope %q", s)
+		}
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+
+	w := c.Root().Writer
+	fmt.Fprintf(w, "# API key (shown once): %s\n", secret)
+	fmt.Fprintf(w, "- id: %s\n", id)
+	fmt.Fprintf(w, "  hash: %s\n", auth.Hash(secret))
+	fmt.Fprintf(w, "  scopes: [%s]\n", strings.Join(c.StringSlice("scope"), ", "))
+	if rate := c.Float("rate"); rate > 0 {
+		fmt.Fprintf(w, "  rate: %g\n", rate)
+		if burst := c.Int("burst"); burst > 0 {
+			fmt.Fprintf(w, "  burst: %d\n", burst)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package breakdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/cmd"
+	"github.com/Elessarov1/geocoder-go/internal/config"
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+
+	"github.com/urfave/cli/v3"
+)
+
+type App struct {
+	cfg config.Config
+}
+
+func CmdBreakdown() *cli.Command {
+	app := &App{}
+	return &cli.Command{
+		Name:      "breakdown",
+		Usage:     "Split IP ranges or CIDRs by country using the configured dataset",
+		ArgsUsage: "<cidr|first-last|ip>...",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "networks",
+				Usage: "list the dataset networks of every country",
+			},
+		},
+		Before: app.before,
+		Action: app.action,
+	}
+}
+
+func (app *App) before(ctx context.Context, _ *cli.Command) (context.Context, error) {
+	var appCtx, cfg, err = cmd.ReadConfig(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	app.cfg = cfg
+
+	return appCtx, nil
+}
+
+func (app *App) action(ctx context.Context, c *cli.Command) error {
+	if c.Args().Len() == 0 {
+		return errors.New("at least one range is required")
+	}
+
+	store, err := cmd.LoadStore(ctx, app.cfg)
+	if err != nil {
+		return err
+	}
+
+	api := geocoder_api.NewService(store, nil, time.Now(), geocoder_api.Options{})
+	items, err := api.GetRangeBreakdown(ctx, c.Args().Slice())
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.Root().Writer, 0, 4, 2, ' ', 0)
+	for i, it := range items {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s (%s addresses)\n", it.Range, it.Addresses)
+		for _, cs := range it.Countries {
+			fmt.Fprintf(w, "  %s\t%s\t%.2f%%", cs.Code, cs.Addresses, cs.Percent)
+			if c.Bool("networks") {
+				nets := make([]string, len(cs.Networks))
+				for i, p := range cs.Networks {
+					nets[i] = p.String()
+				}
+				fmt.Fprintf(w, "\t%s", strings.Join(nets, " "))
+			}
+			fmt.Fprintln(w)
+		}
+		if len(it.Unallocated) > 0 {
+			fmt.Fprintf(w, "  unallocated\t%s\t%.2f%%", it.UnallocatedAddresses, it.UnallocatedPercent)
+			if c.Bool("networks") {
+				ranges := make([]string, len(it.Unallocated))
+				for i, r := range it.Unallocated {
+					ranges[i] = r.String()
+				}
+				fmt.Fprintf(w, "\t%s", strings.Join(ranges, " "))
+			}
+			fmt.Fprintln(w)
+		}
+	}
+	return w.Flush()
+}
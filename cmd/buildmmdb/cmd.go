@@ -0,0 +1,133 @@
+package buildmmdb
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/cmd"
+	"github.com/Elessarov1/geocoder-go/internal/common/logger"
+	"github.com/Elessarov1/geocoder-go/internal/config"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+
+	"github.com/oschwald/maxminddb-golang"
+	"github.com/urfave/cli/v3"
+	"go.uber.org/zap"
+)
+
+type App struct {
+	cfg config.Config
+}
+
+func CmdBuildMMDB() *cli.Command {
+	app := &App{}
+	return &cli.Command{
+		Name:  "build-mmdb",
+		Usage: "Write the configured dataset (with overrides) as an MMDB file",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "path of the MMDB file to write",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "type",
+				Usage: "database_type written to the metadata",
+				Value: geoip.DefaultMMDBOptions().DatabaseType,
+			},
+			&cli.StringFlag{
+				Name:  "description",
+				Usage: "description written to the metadata (default: derived from the source)",
+			},
+			&cli.BoolFlag{
+				Name:  "no-aliases",
+				Usage: "do not link the IPv4-mapped, 6to4 and Teredo ranges to the IPv4 tree",
+			},
+		},
+		Before: app.before,
+		Action: app.action,
+	}
+}
+
+func (app *App) before(ctx context.Context, _ *cli.Command) (context.Context, error) {
+	var appCtx, cfg, err = cmd.ReadConfig(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	app.cfg = cfg
+
+	return appCtx, nil
+}
+
+func (app *App) action(ctx context.Context, c *cli.Command) error {
+	log := logger.FromContext(ctx)
+
+	store, err := cmd.LoadStore(ctx, app.cfg)
+	if err != nil {
+		return err
+	}
+
+	st := store.Stats()
+	log.Info("GeoIP dataset loaded",
+		zap.String("source", app.cfg.GeoCoder.Source),
+		zap.Int("total_networks", st.TotalNetworks),
+		zap.Int("active_overrides", st.ActiveOverrides),
+	)
+
+	opt := geoip.DefaultMMDBOptions()
+	opt.DatabaseType = c.String("type")
+	opt.Description = c.String("description")
+	opt.BuildTime = time.Now()
+	opt.IPv4Aliases = !c.Bool("no-aliases")
+
+	output := c.String("output")
+	if err := writeMMDB(store, output, opt); err != nil {
+		return err
+	}
+
+	log.Info("MMDB written", zap.String("path", output))
+	return nil
+}
+
+// writeMMDB writes to a temporary file next to path, verifies it and
+// renames it into place, so readers never see a partial database.
+func writeMMDB(store *geoip.Store, path string, opt geoip.MMDBOptions) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".build-mmdb-*")
+	if err != nil {
+		return fmt.Errorf("create output: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	w := bufio.NewWriterSize(f, 1<<20)
+	if err := store.WriteMMDB(w, opt); err != nil {
+		f.Close()
+		return fmt.Errorf("write mmdb: %w", err)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("write mmdb: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write mmdb: %w", err)
+	}
+
+	db, err := maxminddb.Open(tmp)
+	if err != nil {
+		return fmt.Errorf("reopen mmdb: %w", err)
+	}
+	err = db.Verify()
+	db.Close()
+	if err != nil {
+		return fmt.Errorf("verify mmdb: %w", err)
+	}
+
+	if err := os.Chmod(tmp, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -2,15 +2,29 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"strings"
 
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+	"github.com/Elessarov1/geocoder-go/internal/certs"
 	"github.com/Elessarov1/geocoder-go/internal/common/logger"
 	"github.com/Elessarov1/geocoder-go/internal/config"
+	"github.com/Elessarov1/geocoder-go/internal/countries"
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
 
+	kitconfig "github.com/Elessarov1/service-kit/config"
 	"github.com/caarlos0/env/v11"
 	"github.com/creasty/defaults"
+	"gopkg.in/yaml.v3"
 )
 
+// ConfigPath is the YAML file with the server and grpc sections (read by
+// service-kit) and the application sections read here.
+const ConfigPath = "config.yml"
+
 func ReadConfig(ctx context.Context) (context.Context, config.Config, error) {
 	var cfg config.Config
 
@@ -22,10 +36,13 @@ func ReadConfig(ctx context.Context) (context.Context, config.Config, error) {
 	defer lg.Sync()
 	ctx = logger.WithLogger(ctx, lg)
 
-	// Load config
+	// Load config: defaults, then the YAML sections, then the environment.
 	if err := defaults.Set(&cfg); err != nil {
 		return ctx, cfg, fmt.Errorf("failed to set defaults: %w", err)
 	}
+	if err := readYAMLSections(ConfigPath, &cfg); err != nil {
+		return ctx, cfg, fmt.Errorf("failed to read %s: %w", ConfigPath, err)
+	}
 	if err := env.Parse(&cfg); err != nil {
 		return ctx, cfg, fmt.Errorf("failed to parse env: %w", err)
 	}
@@ -41,3 +58,107 @@ func ReadConfig(ctx context.Context) (context.Context, config.Config, error) {
 
 	return ctx, cfg, nil
 }
+
+// readYAMLSections fills the parts of cfg that live in the YAML file next to
+// the service-kit sections: groups, geocoder, proxy and the tls keys of the
+// server and grpc sections. ${ENV:default} references are expanded as for the
+// service-kit sections. Keys missing from the file keep their current
+// values; a missing file leaves everything as is.
+func readYAMLSections(path string, cfg *config.Config) error {
+	raw, err := kitconfig.ReadYAML(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	sections := []struct {
+		name, key string // key, if set, is read from the section
+		dst       any
+	}{
+		{"groups", "", &cfg.Groups},
+		{"geocoder", "", &cfg.GeoCoder.Loader},
+		{"proxy", "", &cfg.Proxy},
+		{"server", "tls", &cfg.ServerTLS},
+		{"grpc", "tls", &cfg.GRPCTLS},
+	}
+	for _, sec := range sections {
+		v, ok := raw[sec.name]
+		if ok && sec.key != "" {
+			m, _ := v.(map[string]any)
+			v, ok = m[sec.key]
+		}
+		if !ok {
+			continue
+		}
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(b, sec.dst); err != nil {
+			return fmt.Errorf("%s: %w", strings.TrimSuffix(sec.name+"."+sec.key, "."), err)
+		}
+	}
+	return nil
+}
+
+// Groups builds the country group catalogue: built-in groups plus the ones
+// from the config.
+func Groups(cfg config.Config) (*countries.Groups, error) {
+	defs := make([]countries.GroupDef, 0, len(cfg.Groups))
+	for _, g := range cfg.Groups {
+		defs = append(defs, countries.GroupDef{ID: g.ID, Name: g.Name, Members: g.Members})
+	}
+	return countries.NewGroups(defs)
+}
+
+// UnwrapAliases returns the tunnel kinds lookups unwrap to IPv4. The config
+// is validated, so every name parses.
+func UnwrapAliases(cfg config.Config) []geoip.AliasKind {
+	out := make([]geoip.AliasKind, 0, len(cfg.GeoCoder.Loader.UnwrapAliases))
+	for _, name := range cfg.GeoCoder.Loader.UnwrapAliases {
+		if k, ok := geoip.ParseAliasKind(name); ok {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// InputOptions returns the address spellings lookups accept.
+func InputOptions(cfg config.Config) geocoder_api.InputOptions {
+	var in geocoder_api.InputOptions
+	for _, form := range cfg.GeoCoder.Loader.IPInput {
+		switch form {
+		case "port":
+			in.Ports = true
+		case "brackets":
+			in.Brackets = true
+		case "zone":
+			in.Zones = true
+		case "integer":
+			in.Integers = true
+		case "cidr":
+			in.CIDRs = true
+		}
+	}
+	return in
+}
+
+// APIKeys reads the configured API keys; nil leaves the servers open.
+func APIKeys(cfg config.Config) (*auth.Keyring, error) {
+	if cfg.Auth.KeysFile == "" {
+		return nil, nil
+	}
+	return auth.ReadKeyFile(cfg.Auth.KeysFile)
+}
+
+// TLS returns the TLS files of a listener, reloaded as they rotate; nil
+// when TLS is off.
+func TLS(ctx context.Context, tc config.TLSConfig) (*certs.Reloader, error) {
+	if !tc.Enabled {
+		return nil, nil
+	}
+	return certs.NewReloader(tc.CertFile, tc.KeyFile, tc.ClientCAFile,
+		tc.ClientAuth == config.ClientAuthOptional, tc.ReloadInterval, logger.FromContext(ctx))
+}
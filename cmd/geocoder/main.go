@@ -6,6 +6,10 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/Elessarov1/geocoder-go/cmd/apikey"
+	"github.com/Elessarov1/geocoder-go/cmd/breakdown"
+	"github.com/Elessarov1/geocoder-go/cmd/buildmmdb"
+	"github.com/Elessarov1/geocoder-go/cmd/quality"
 	"github.com/Elessarov1/geocoder-go/cmd/start"
 	"github.com/Elessarov1/geocoder-go/internal/common/version"
 
@@ -22,6 +26,10 @@ func main() {
 		Version: version.Version(),
 		Commands: []*cli.Command{
 			start.CmdStart(),
+			buildmmdb.CmdBuildMMDB(),
+			breakdown.CmdBreakdown(),
+			quality.CmdQuality(),
+			apikey.CmdAPIKey(),
 		},
 	}
 
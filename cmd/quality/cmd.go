@@ -0,0 +1,111 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/cmd"
+	"github.com/Elessarov1/geocoder-go/internal/config"
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+
+	"github.com/urfave/cli/v3"
+)
+
+type App struct {
+	cfg config.Config
+}
+
+func CmdQuality() *cli.Command {
+	app := &App{}
+	return &cli.Command{
+		Name:  "quality",
+		Usage: "Report suspicious networks and sharp country changes in the configured dataset",
+		Flags: []cli.Flag{
+			&cli.IntFlag{
+				Name:  "limit",
+				Value: 100,
+				Usage: "entries listed per section",
+			},
+			&cli.FloatFlag{
+				Name:  "threshold",
+				Value: 20,
+				Usage: "report countries whose counts changed by at least this many percent",
+			},
+			&cli.IntFlag{
+				Name:  "min-networks",
+				Value: 10,
+				Usage: "do not compare countries with fewer networks in both builds",
+			},
+		},
+		Before: app.before,
+		Action: app.action,
+	}
+}
+
+func (app *App) before(ctx context.Context, _ *cli.Command) (context.Context, error) {
+	var appCtx, cfg, err = cmd.ReadConfig(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	app.cfg = cfg
+
+	return appCtx, nil
+}
+
+func (app *App) action(ctx context.Context, c *cli.Command) error {
+	store, err := cmd.LoadStore(ctx, app.cfg)
+	if err != nil {
+		return err
+	}
+
+	api := geocoder_api.NewService(store, nil, time.Now(), geocoder_api.Options{})
+	rep, err := api.GetQualityReport(ctx, c.Int("limit"), c.Float("threshold"), c.Int("min-networks"))
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(c.Root().Writer, 0, 4, 2, ' ', 0)
+
+	header(w, "Country differs from registered country", len(rep.RegisteredDiffers), rep.TotalRegisteredDiffers)
+	for _, it := range rep.RegisteredDiffers {
+		fmt.Fprintf(w, "  %s\t%s\tregistered %s\t%s\n", it.Network, it.Code, it.RegisteredCode, it.Provenance)
+	}
+
+	fmt.Fprintln(w)
+	header(w, "Fallback code", len(rep.Fallbacks), rep.TotalFallbacks)
+	for _, it := range rep.Fallbacks {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", it.Network, it.Code, it.Provenance)
+	}
+
+	fmt.Fprintln(w)
+	header(w, "Alias conflicts", len(rep.AliasConflicts), rep.TotalAliasConflicts)
+	for _, it := range rep.AliasConflicts {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", it.Network, it.Code, it.Alias, it.IPv4, it.IPv4Code)
+	}
+
+	if !rep.HasPrevious {
+		fmt.Fprintln(w, "\nCountry changes: no previous build known")
+		return w.Flush()
+	}
+	fmt.Fprintln(w)
+	header(w, "Country changes", len(rep.Changes), rep.TotalChanges)
+	fmt.Fprintln(w, "  code\tchange\tnetworks\tipv4 addresses\tipv6 /64s")
+	for _, it := range rep.Changes {
+		fmt.Fprintf(w, "  %s\t%+.1f%%\t%d -> %d\t%d -> %d\t%d -> %d\n", it.Code, it.Percent,
+			it.Before.Networks, it.After.Networks,
+			it.Before.IPv4Addresses, it.After.IPv4Addresses,
+			it.Before.IPv6Slash64s, it.After.IPv6Slash64s)
+	}
+	return w.Flush()
+}
+
+func header(w io.Writer, title string, shown, total int) {
+	if shown < total {
+		fmt.Fprintf(w, "%s: %d (first %d)\n", title, total, shown)
+		return
+	}
+	fmt.Fprintf(w, "%s: %d\n", title, total)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"slices"
 	"time"
 
 	"github.com/Elessarov1/geocoder-go/cmd"
@@ -57,13 +58,21 @@ func (app *App) action(ctx context.Context, _ *cli.Command) error {
 	log := logger.FromContext(ctx)
 
 	log.Info("Starting geocoder",
+		zap.String("source", cfg.GeoCoder.Source),
+		zap.Strings("layers", cfg.GeoCoder.Layers),
 		zap.String("path", cfg.GeoCoder.GeoIPDbPath),
+		zap.Strings("rir_files", cfg.GeoCoder.RIRFiles),
+		zap.Strings("csv_blocks", cfg.GeoCoder.CSVBlocks),
+		zap.String("snapshot", cfg.GeoCoder.SnapshotPath),
 		zap.Bool("debug", cfg.GeoCoder.Debug),
+		zap.Bool("admin", cfg.GeoCoder.AdminEnabled),
+		zap.Bool("server_tls", cfg.ServerTLS.Enabled),
+		zap.Bool("grpc_tls", cfg.GRPCTLS.Enabled),
 	)
 
 	logMem(log, "mem_before_load")
 
-	store, err := app.loadGeoIP(ctx, cfg)
+	store, err := cmd.LoadStore(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -77,23 +86,70 @@ func (app *App) action(ctx context.Context, _ *cli.Command) error {
 		zap.Int("unique_countries", st.UniqueCountries),
 		zap.Int("ipv4_networks", st.V4Networks),
 		zap.Int("ipv6_networks", st.V6Networks),
+		zap.Int("active_overrides", st.ActiveOverrides),
 	)
+	for _, src := range store.Sources() {
+		log.Info("GeoIP source",
+			zap.String("name", src.Name),
+			zap.String("type", src.Dataset.Type),
+			zap.Time("build_time", src.Dataset.BuildTime),
+			zap.Int("networks", src.Networks),
+		)
+	}
 
 	//runtime.GC()
 	//logMem(log, "mem_after_load_after_gc")
 
-	mmdb, err := maxminddb.Open(cfg.GeoCoder.GeoIPDbPath)
+	// The reader answers lookups with full records, in layered datasets for
+	// the addresses the MMDB layer won; other sources are served from the
+	// store alone.
+	var mmdb *maxminddb.Reader
+	if cfg.GeoCoder.Source == config.SourceMMDB ||
+		cfg.GeoCoder.Source == config.SourceLayered && slices.Contains(cfg.GeoCoder.Layers, config.SourceMMDB) {
+		mmdb, err = maxminddb.Open(cfg.GeoCoder.GeoIPDbPath)
+		if err != nil {
+			return fmt.Errorf("open mmdb: %w", err)
+		}
+		defer mmdb.Close()
+	}
+
+	groups, err := cmd.Groups(cfg)
+	if err != nil {
+		return fmt.Errorf("country groups: %w", err)
+	}
+
+	keys, err := cmd.APIKeys(cfg)
 	if err != nil {
-		return fmt.Errorf("open mmdb: %w", err)
+		return fmt.Errorf("api keys: %w", err)
+	}
+	if keys != nil {
+		log.Info("API key authentication enabled", zap.Int("keys", len(keys.Keys())))
 	}
-	defer mmdb.Close()
 
-	api := geocoder_api.NewService(store, mmdb, time.Now())
+	httpTLS, err := cmd.TLS(ctx, cfg.ServerTLS)
+	if err != nil {
+		return fmt.Errorf("server tls: %w", err)
+	}
+	grpcTLS, err := cmd.TLS(ctx, cfg.GRPCTLS)
+	if err != nil {
+		return fmt.Errorf("grpc tls: %w", err)
+	}
+
+	api := geocoder_api.NewService(store, mmdb, time.Now(), geocoder_api.Options{
+		AdminEnabled:  cfg.GeoCoder.AdminEnabled,
+		Groups:        groups,
+		UnwrapAliases: cmd.UnwrapAliases(cfg),
+		Input:         cmd.InputOptions(cfg),
+	})
 
 	// ===== service-kit =====
 
-	configPath := "config.yml"
-	reg := bootstrap.Registry(api)
+	configPath := cmd.ConfigPath
+	reg := bootstrap.Registry(api, cfg, bootstrap.Security{
+		Keys:    keys,
+		HTTPTLS: httpTLS,
+		GRPCTLS: grpcTLS,
+	})
 	g, ctx := errgroup.WithContext(ctx)
 
 	// Run HTTP components via service-kit (reads YAML, starts, waits, stops).
@@ -101,6 +157,12 @@ func (app *App) action(ctx context.Context, _ *cli.Command) error {
 		return kitcore.Run(ctx, configPath, reg, kitcore.WithStopTimeout(10*time.Second))
 	})
 
+	// The store keeps the networks of an override until it is rebuilt.
+	g.Go(func() error {
+		rebuildOnExpiry(ctx, cfg, store, api)
+		return nil
+	})
+
 	if err := g.Wait(); err != nil {
 		if !errors.Is(err, context.Canceled) {
 			return err
@@ -111,9 +173,46 @@ func (app *App) action(ctx context.Context, _ *cli.Command) error {
 	return nil
 }
 
-func (app *App) loadGeoIP(ctx context.Context, cfg config.Config) (*geoip.Store, error) {
-	opt := geoip.DefaultOptions()
-	return geoip.Load(ctx, cfg.GeoCoder.GeoIPDbPath, opt)
+// expiryRetry is how long a failed rebuild waits before the next attempt.
+const expiryRetry = time.Minute
+
+// rebuildOnExpiry rebuilds the store whenever the first of its overrides
+// expires and hands the result to api, until ctx is done. A failed rebuild
+// keeps the current store; its lookups already skip the expired override.
+func rebuildOnExpiry(ctx context.Context, cfg config.Config, store *geoip.Store, api *geocoder_api.Service) {
+	log := logger.FromContext(ctx)
+	for {
+		at, ok := store.NextExpiry()
+		if !ok {
+			return
+		}
+
+		t := time.NewTimer(time.Until(at))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+
+		next, err := cmd.LoadStore(ctx, cfg)
+		for err != nil {
+			log.Error("GeoIP rebuild after override expiry failed", zap.Error(err))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(expiryRetry):
+			}
+			next, err = cmd.LoadStore(ctx, cfg)
+		}
+
+		store = next
+		api.SetStore(store)
+		log.Info("GeoIP database rebuilt after override expiry",
+			zap.Time("expired_at", at),
+			zap.Int("active_overrides", store.Stats().ActiveOverrides),
+		)
+	}
 }
 
 func logMem(log *zap.Logger, prefix string) {
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/common/logger"
+	"github.com/Elessarov1/geocoder-go/internal/config"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+
+	"go.uber.org/zap"
+)
+
+// LoadStore builds the geoip store from the configured source, with the
+// configured override files applied. With a snapshot path configured, a
+// snapshot matching the current inputs is loaded instead, and a fresh one is
+// written after every rebuild, remembering the counts of the one it replaces.
+func LoadStore(ctx context.Context, cfg config.Config) (*geoip.Store, error) {
+	path := cfg.GeoCoder.SnapshotPath
+	if path == "" {
+		return buildStore(ctx, cfg)
+	}
+	log := logger.FromContext(ctx)
+
+	key, err := snapshotKey(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot key: %w", err)
+	}
+
+	store, err := geoip.ReadSnapshot(path, key)
+	switch {
+	case err == nil:
+		log.Info("GeoIP snapshot loaded", zap.String("path", path))
+		return store, nil
+	case errors.Is(err, fs.ErrNotExist):
+	default:
+		log.Warn("GeoIP snapshot ignored, rebuilding", zap.String("path", path), zap.Error(err))
+	}
+
+	store, err = buildStore(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The snapshot being replaced is the previous build the quality report
+	// compares against.
+	if prev, err := geoip.ReadPreviousSnapshot(path); err == nil {
+		store.SetPrevious(prev)
+	}
+
+	// A failed write only costs the next start a full load.
+	if err := writeSnapshot(store, path, key); err != nil {
+		log.Warn("Failed to write GeoIP snapshot", zap.String("path", path), zap.Error(err))
+	} else {
+		log.Info("GeoIP snapshot written", zap.String("path", path))
+	}
+	return store, nil
+}
+
+func buildStore(ctx context.Context, cfg config.Config) (*geoip.Store, error) {
+	opt := loaderOptions(cfg.GeoCoder.Loader)
+
+	overrides, err := geoip.ReadOverrideFiles(cfg.GeoCoder.OverrideFiles)
+	if err != nil {
+		return nil, err
+	}
+	opt.Overrides = overrides
+
+	if cfg.GeoCoder.Source != config.SourceLayered {
+		return sourceLoader(cfg, cfg.GeoCoder.Source)(ctx, opt)
+	}
+
+	layers := make([]geoip.Layer, 0, len(cfg.GeoCoder.Layers))
+	for _, name := range cfg.GeoCoder.Layers {
+		layers = append(layers, geoip.Layer{Name: name, Load: sourceLoader(cfg, name)})
+	}
+	return geoip.LoadLayered(ctx, layers, opt)
+}
+
+// loaderOptions maps the geocoder section onto geoip.Options. The config
+// is validated, so every preference entry parses.
+func loaderOptions(lc config.LoaderConfig) geoip.Options {
+	opt := geoip.Options{
+		SkipAliasedNetworks: lc.SkipAliasedNetworks,
+		UnknownISO:          lc.UnknownISO,
+		SkipUnknown:         !lc.IncludeUnknown,
+		SkipIPv4:            lc.Family == config.FamilyIPv6,
+		SkipIPv6:            lc.Family == config.FamilyIPv4,
+	}
+	for _, name := range lc.CountryPreference {
+		if src, ok := geoip.ParseCodeSource(name); ok {
+			opt.Preference = append(opt.Preference, src)
+		}
+	}
+	return opt
+}
+
+func sourceLoader(cfg config.Config, source string) func(context.Context, geoip.Options) (*geoip.Store, error) {
+	gc := cfg.GeoCoder
+	return func(ctx context.Context, opt geoip.Options) (*geoip.Store, error) {
+		switch source {
+		case config.SourceRIR:
+			return geoip.LoadRIR(ctx, gc.RIRFiles, opt)
+		case config.SourceCSV:
+			return geoip.LoadCSV(ctx, geoip.CSVSource{
+				Format:    geoip.CSVFormat(gc.CSVFormat),
+				Blocks:    gc.CSVBlocks,
+				Locations: gc.CSVLocations,
+			}, opt)
+		default:
+			return geoip.Load(ctx, gc.GeoIPDbPath, opt)
+		}
+	}
+}
+
+// snapshotKey fingerprints every input file of the configured sources, the
+// override files and the settings that shape the store.
+func snapshotKey(cfg config.Config) (string, error) {
+	gc := cfg.GeoCoder
+
+	sources := []string{gc.Source}
+	if gc.Source == config.SourceLayered {
+		sources = gc.Layers
+	}
+
+	var files []string
+	for _, src := range sources {
+		switch src {
+		case config.SourceRIR:
+			paths, err := geoip.ExpandPaths(gc.RIRFiles)
+			if err != nil {
+				return "", err
+			}
+			files = append(files, paths...)
+		case config.SourceCSV:
+			paths, err := geoip.ExpandPaths(gc.CSVBlocks)
+			if err != nil {
+				return "", err
+			}
+			files = append(files, paths...)
+			if gc.CSVLocations != "" {
+				files = append(files, gc.CSVLocations)
+			}
+		default:
+			files = append(files, gc.GeoIPDbPath)
+		}
+	}
+	for _, p := range gc.OverrideFiles {
+		if p = strings.TrimSpace(p); p != "" {
+			files = append(files, p)
+		}
+	}
+
+	return geoip.SnapshotKey(files,
+		"source="+gc.Source,
+		"layers="+strings.Join(gc.Layers, ","),
+		"csv_format="+gc.CSVFormat,
+		"unknown_iso="+gc.Loader.UnknownISO,
+		fmt.Sprintf("skip_aliased=%t", gc.Loader.SkipAliasedNetworks),
+		"preference="+strings.Join(gc.Loader.CountryPreference, ","),
+		fmt.Sprintf("include_unknown=%t", gc.Loader.IncludeUnknown),
+		"family="+gc.Loader.Family,
+	)
+}
+
+func writeSnapshot(store *geoip.Store, path, key string) error {
+	f, err := os.CreateTemp(filepath.Dir(path), ".snapshot-*")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if err := store.WriteSnapshot(f, key); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
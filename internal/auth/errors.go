@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"math"
+	"time"
+)
+
+// UnauthenticatedError is returned for a missing or unknown key.
+type UnauthenticatedError struct {
+	Msg string
+}
+
+func (e *UnauthenticatedError) Error() string {
+	return e.Msg
+}
+
+// ForbiddenError is returned when the key lacks the operation's scope.
+type ForbiddenError struct {
+	Msg string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Msg
+}
+
+// QuotaExceededError is returned when the key is over its rate limit.
+type QuotaExceededError struct {
+	Msg        string
+	RetryAfter time.Duration
+}
+
+func (e *QuotaExceededError) Error() string {
+	return e.Msg
+}
+
+// RetryAfterSeconds is RetryAfter in whole seconds, at least 1, as sent in
+// Retry-After.
+func (e *QuotaExceededError) RetryAfterSeconds() int {
+	return int(math.Max(1, math.Ceil(e.RetryAfter.Seconds())))
+}
@@ -0,0 +1,227 @@
+// Package auth authenticates API keys for the HTTP and gRPC servers and
+// enforces their scopes and rate limits.
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/ratelimit"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HashPrefix marks the hash of a key secret in the keys file.
+const HashPrefix = "sha256:"
+
+// Key is an API key from the keys file.
+type Key struct {
+	ID string
+	// Subject is the client certificate subject that authenticates as this
+	// key over mTLS, "" if none does.
+	Subject string
+	Scopes  []Scope
+	// Rate is the allowed requests per second, Burst the requests allowed at
+	// once; a zero rate means unlimited.
+	Rate  float64
+	Burst int
+
+	hash   [sha256.Size]byte
+	bucket *ratelimit.Bucket
+}
+
+// Allows reports whether the key grants scope. The admin scope grants all.
+func (k *Key) Allows(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Keyring holds the API keys, looked up by the hash of their secret or by
+// client certificate subject.
+type Keyring struct {
+	keys      []*Key
+	byHash    map[[sha256.Size]byte]*Key
+	bySubject map[string]*Key
+}
+
+// Credentials are what a request presents: a key secret and the subject of
+// its verified client certificate. Either may be empty.
+type Credentials struct {
+	Secret  string
+	Subject string
+}
+
+// Hash returns the keys file form of a key secret.
+func Hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return HashPrefix + hex.EncodeToString(sum[:])
+}
+
+type keyFileYAML struct {
+	Keys []struct {
+		ID      string   `yaml:"id"`
+		Hash    string   `yaml:"hash"`
+		Subject string   `yaml:"subject"`
+		Scopes  []string `yaml:"scopes"`
+		Rate    float64  `yaml:"rate"`
+		Burst   int      `yaml:"burst"`
+	} `yaml:"keys"`
+}
+
+// ReadKeyFile reads a YAML file holding a "keys" list of {id, hash, subject,
+// scopes, rate, burst} objects. Hashes are "sha256:" followed by the hex
+// SHA-256 of the secret, as printed by Hash. Subjects are client certificate
+// subjects as in "CN=billing,O=Example"; a key needs a hash, a subject or
+// both.
+func ReadKeyFile(path string) (*Keyring, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc keyFileYAML
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("read keys %s: %w", path, err)
+	}
+
+	kr := &Keyring{
+		byHash:    make(map[[sha256.Size]byte]*Key, len(doc.Keys)),
+		bySubject: make(map[string]*Key),
+	}
+	ids := make(map[string]bool, len(doc.Keys))
+	for i, it := range doc.Keys {
+		origin := fmt.Sprintf("%s:keys[%d]", path, i)
+
+		k := &Key{
+			ID:      strings.TrimSpace(it.ID),
+			Subject: strings.TrimSpace(it.Subject),
+			Rate:    it.Rate,
+			Burst:   it.Burst,
+		}
+		if k.ID == "" {
+			return nil, fmt.Errorf("%s: id is required", origin)
+		}
+		if ids[k.ID] {
+			return nil, fmt.Errorf("%s: duplicate id %q", origin, k.ID)
+		}
+		ids[k.ID] = true
+
+		hasHash := strings.TrimSpace(it.Hash) != ""
+		if !hasHash && k.Subject == "" {
+			return nil, fmt.Errorf("%s: hash or subject is required", origin)
+		}
+		if hasHash {
+			if err := parseHash(it.Hash, &k.hash); err != nil {
+				return nil, fmt.Errorf("%s: %w", origin, err)
+			}
+			if _, dup := kr.byHash[k.hash]; dup {
+				return nil, fmt.Errorf("%s: hash of key %q is used twice", origin, k.ID)
+			}
+			kr.byHash[k.hash] = k
+		}
+		if k.Subject != "" {
+			if _, dup := kr.bySubject[k.Subject]; dup {
+				return nil, fmt.Errorf("%s: subject %q is used twice", origin, k.Subject)
+			}
+			kr.bySubject[k.Subject] = k
+		}
+
+		for _, name := range it.Scopes {
+			s, ok := ParseScope(name)
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown scope %q", origin, name)
+			}
+			k.Scopes = append(k.Scopes, s)
+		}
+
+		if k.Rate < 0 || k.Burst < 0 {
+			return nil, fmt.Errorf("%s: rate and burst must not be negative", origin)
+		}
+		if k.Rate > 0 {
+			k.bucket = ratelimit.NewBucket(k.Rate, k.Burst)
+		}
+
+		kr.keys = append(kr.keys, k)
+	}
+	return kr, nil
+}
+
+func parseHash(s string, dst *[sha256.Size]byte) error {
+	h, ok := strings.CutPrefix(strings.TrimSpace(s), HashPrefix)
+	if !ok {
+		return fmt.Errorf("hash must start with %q", HashPrefix)
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil || len(b) != sha256.Size {
+		return fmt.Errorf("hash must be %d hex-encoded bytes", sha256.Size)
+	}
+	copy(dst[:], b)
+	return nil
+}
+
+// Keys returns the keys in file order.
+func (kr *Keyring) Keys() []*Key {
+	return kr.keys
+}
+
+// Authorize checks a request for operation made with cred at now: the key
+// (found by secret, or else by client certificate subject) must exist, grant
+// the operation's scope and be within its rate limit. Public operations pass
+// without a key and return a nil key.
+func (kr *Keyring) Authorize(cred Credentials, operation string, now time.Time) (*Key, error) {
+	scope := OperationScope(operation)
+	if scope == ScopePublic {
+		return nil, nil
+	}
+
+	var k *Key
+	switch {
+	case cred.Secret != "":
+		k = kr.byHash[sha256.Sum256([]byte(cred.Secret))]
+		if k == nil {
+			return nil, &UnauthenticatedError{Msg: "invalid api key"}
+		}
+	case cred.Subject != "":
+		k = kr.bySubject[cred.Subject]
+		if k == nil {
+			return nil, &UnauthenticatedError{Msg: fmt.Sprintf("no api key for client certificate %q", cred.Subject)}
+		}
+	default:
+		return nil, &UnauthenticatedError{Msg: "api key required"}
+	}
+
+	if !k.Allows(scope) {
+		return k, &ForbiddenError{Msg: fmt.Sprintf("api key %q lacks the %s scope", k.ID, scope)}
+	}
+	if k.bucket != nil {
+		if ok, wait := k.bucket.Take(now); !ok {
+			return k, &QuotaExceededError{
+				Msg:        fmt.Sprintf("api key %q is over its rate limit", k.ID),
+				RetryAfter: wait,
+			}
+		}
+	}
+	return k, nil
+}
+
+type keyCtxKey struct{}
+
+// WithKey stores the authenticated key in ctx.
+func WithKey(ctx context.Context, k *Key) context.Context {
+	return context.WithValue(ctx, keyCtxKey{}, k)
+}
+
+// KeyFromContext returns the key the request was authenticated with.
+func KeyFromContext(ctx context.Context) (*Key, bool) {
+	k, ok := ctx.Value(keyCtxKey{}).(*Key)
+	return k, ok && k != nil
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeKeyFile writes a keys file and returns its path.
+func writeKeyFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// testKeyring holds a lookup key limited to 2 requests at once and 1 per
+// second, an admin key and a lookup key known by certificate subject.
+func testKeyring(t *testing.T) *Keyring {
+	t.Helper()
+	kr, err := ReadKeyFile(writeKeyFile(t, `
+keys:
+  - id: reader
+    hash: `+Hash("reader-secret")+`
+    scopes: [lookup]
+    rate: 1
+    burst: 2
+  - id: ops
+    hash: `+Hash("ops-secret")+`
+    scopes: [admin]
+  - id: billing
+    subject: CN=billing,O=Example
+    scopes: [lookup]
+`))
+	if err != nil {
+		t.Fatalf("ReadKeyFile: %v", err)
+	}
+	return kr
+}
+
+func TestReadKeyFileRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no prefix", "keys:\n  - id: a\n    hash: " + strings.TrimPrefix(Hash("x"), HashPrefix), "hash must start with"},
+		{"not hex", "keys:\n  - id: a\n    hash: sha256:zz", "hex-encoded"},
+		{"short", "keys:\n  - id: a\n    hash: sha256:abcd", "hex-encoded"},
+		{"duplicate hash", "keys:\n  - id: a\n    hash: " + Hash("x") + "\n  - id: b\n    hash: " + Hash("x"), "used twice"},
+		{"duplicate id", "keys:\n  - id: a\n    hash: " + Hash("x") + "\n  - id: a\n    hash: " + Hash("y"), "duplicate id"},
+		{"duplicate subject", "keys:\n  - id: a\n    subject: CN=a\n  - id: b\n    subject: CN=a", "used twice"},
+		{"no credentials", "keys:\n  - id: a\n    scopes: [lookup]", "hash or subject is required"},
+		{"unknown scope", "keys:\n  - id: a\n    hash: " + Hash("x") + "\n    scopes: [root]", "unknown scope"},
+		{"negative rate", "keys:\n  - id: a\n    hash: " + Hash("x") + "\n    rate: -1", "must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadKeyFile(writeKeyFile(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ReadKeyFile error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		cred   Credentials
+		op     string
+		wantID string // "" for no key
+		want   any    // nil, or a pointer to the error type expected
+	}{
+		{"public without key", Credentials{}, "GetHealth", "", nil},
+		{"public with unknown key", Credentials{Secret: "nope"}, "gethealth", "", nil},
+		{"missing key", Credentials{}, "GetIpData", "", &UnauthenticatedError{}},
+		{"unknown secret", Credentials{Secret: "nope"}, "GetIpData", "", &UnauthenticatedError{}},
+		{"unknown subject", Credentials{Subject: "CN=other"}, "GetIpData", "", &UnauthenticatedError{}},
+		{"in scope", Credentials{Secret: "reader-secret"}, "GetIpData", "reader", nil},
+		{"wrong scope", Credentials{Secret: "reader-secret"}, "GetCountryNetworks", "reader", &ForbiddenError{}},
+		{"admin grants all", Credentials{Secret: "ops-secret"}, "GetCountryNetworks", "ops", nil},
+		{"unknown operation needs admin", Credentials{Secret: "reader-secret"}, "DropTables", "reader", &ForbiddenError{}},
+		{"unknown operation with admin", Credentials{Secret: "ops-secret"}, "DropTables", "ops", nil},
+		{"subject", Credentials{Subject: "CN=billing,O=Example"}, "GetIpData", "billing", nil},
+		{"secret wins over subject", Credentials{Secret: "ops-secret", Subject: "CN=billing,O=Example"}, "ExplainIp", "ops", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := testKeyring(t).Authorize(tt.cred, tt.op, now)
+
+			gotID := ""
+			if k != nil {
+				gotID = k.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("key = %q, want %q", gotID, tt.wantID)
+			}
+
+			switch want := tt.want.(type) {
+			case nil:
+				if err != nil {
+					t.Errorf("err = %v, want nil", err)
+				}
+			case *UnauthenticatedError:
+				if !errors.As(err, &want) {
+					t.Errorf("err = %v, want UnauthenticatedError", err)
+				}
+			case *ForbiddenError:
+				if !errors.As(err, &want) {
+					t.Errorf("err = %v, want ForbiddenError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizeRateLimit(t *testing.T) {
+	kr := testKeyring(t)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cred := Credentials{Secret: "reader-secret"}
+
+	for i := range 2 {
+		if _, err := kr.Authorize(cred, "GetIpData", now); err != nil {
+			t.Fatalf("request %d within burst: %v", i, err)
+		}
+	}
+
+	_, err := kr.Authorize(cred, "GetIpData", now.Add(500*time.Millisecond))
+	var qe *QuotaExceededError
+	if !errors.As(err, &qe) {
+		t.Fatalf("err = %v, want QuotaExceededError", err)
+	}
+	if qe.RetryAfter != 500*time.Millisecond || qe.RetryAfterSeconds() != 1 {
+		t.Errorf("retry after %s (%ds), want 500ms (1s)", qe.RetryAfter, qe.RetryAfterSeconds())
+	}
+
+	// Public operations take no token.
+	if _, err := kr.Authorize(cred, "GetHealth", now.Add(500*time.Millisecond)); err != nil {
+		t.Errorf("public call on a dry bucket: %v", err)
+	}
+	if _, err := kr.Authorize(cred, "GetIpData", now.Add(time.Second)); err != nil {
+		t.Errorf("after refill: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Results of an authorization check, as counted in the metrics.
+const (
+	ResultOK              = "ok"
+	ResultUnauthenticated = "unauthenticated"
+	ResultForbidden       = "forbidden"
+	ResultRateLimited     = "rate_limited"
+)
+
+var requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "geocoder_auth_requests_total",
+	Help: "Authorized and rejected requests by API key, transport and result.",
+}, []string{"key", "transport", "result"})
+
+func init() {
+	prometheus.MustRegister(requests)
+}
+
+// Result classifies the outcome of Authorize.
+func Result(err error) string {
+	var (
+		ue *UnauthenticatedError
+		fe *ForbiddenError
+		qe *QuotaExceededError
+	)
+	switch {
+	case err == nil:
+		return ResultOK
+	case errors.As(err, &ue):
+		return ResultUnauthenticated
+	case errors.As(err, &fe):
+		return ResultForbidden
+	case errors.As(err, &qe):
+		return ResultRateLimited
+	}
+	return ResultUnauthenticated
+}
+
+// Observe counts the outcome of Authorize on transport (http or grpc).
+// Public requests are not counted; requests without a known key are counted
+// under the key "-".
+func Observe(transport string, k *Key, err error) {
+	if k == nil && err == nil {
+		return
+	}
+	id := "-"
+	if k != nil {
+		id = k.ID
+	}
+	requests.WithLabelValues(id, transport, Result(err)).Inc()
+}
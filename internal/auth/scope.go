@@ -0,0 +1,68 @@
+package auth
+
+import "strings"
+
+// Scope is a group of operations a key may call.
+type Scope string
+
+const (
+	// ScopePublic operations need no key.
+	ScopePublic Scope = ""
+	// ScopeLookup covers address and range lookups and the reference data.
+	ScopeLookup Scope = "lookup"
+	// ScopeNetworks covers the network lists of countries and expressions.
+	ScopeNetworks Scope = "networks"
+	// ScopeAdmin covers the diagnostic operations and grants every other
+	// scope.
+	ScopeAdmin Scope = "admin"
+)
+
+// ParseScope parses a scope name from the keys file.
+func ParseScope(s string) (Scope, bool) {
+	switch sc := Scope(strings.ToLower(strings.TrimSpace(s))); sc {
+	case ScopeLookup, ScopeNetworks, ScopeAdmin:
+		return sc, true
+	}
+	return ScopePublic, false
+}
+
+func (s Scope) String() string {
+	if s == ScopePublic {
+		return "public"
+	}
+	return string(s)
+}
+
+// operationScopes maps operations to scopes. OpenAPI operation IDs and gRPC
+// method names differ only in case, so both are looked up lower-cased.
+var operationScopes = map[string]Scope{
+	"gethealth": ScopePublic,
+	// gRPC reflection serves the schema, public like the swagger files.
+	"serverreflectioninfo": ScopePublic,
+
+	"getipdata":         ScopeLookup,
+	"getcidrdata":       ScopeLookup,
+	"getrangebreakdown": ScopeLookup,
+	"getbogons":         ScopeLookup,
+	"getcountries":      ScopeLookup,
+	"getcountry":        ScopeLookup,
+	"getgroups":         ScopeLookup,
+	"getdataset":        ScopeLookup,
+
+	"getcountrynetworks":       ScopeNetworks,
+	"getcountrynetworkspaged":  ScopeNetworks,
+	"getcountrynetworksstream": ScopeNetworks,
+	"evaluateexpression":       ScopeNetworks,
+
+	"getqualityreport": ScopeAdmin,
+	"explainip":        ScopeAdmin,
+}
+
+// OperationScope returns the scope of an OpenAPI operation ID or gRPC method
+// name. Unknown operations need the admin scope.
+func OperationScope(operation string) Scope {
+	if s, ok := operationScopes[strings.ToLower(operation)]; ok {
+		return s
+	}
+	return ScopeAdmin
+}
@@ -0,0 +1,101 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	http_server "github.com/Elessarov1/service-kit/component/server"
+)
+
+// httpsRuntime serves the routes of the standard service-kit runtime over
+// TLS. The standard runtime only listens on plaintext, so it is used to
+// build the mux (API, metrics, swagger) and a TLS server of our own serves
+// it.
+type httpsRuntime struct {
+	cfg  http_server.Config
+	opts http_server.StdOptions
+	srv  *http.Server
+}
+
+func newHTTPSRuntime(ctx context.Context, cfg http_server.Config, opts http_server.StdOptions, tlsConf *tls.Config) (*httpsRuntime, error) {
+	var mux *http.ServeMux
+	std := opts
+	std.Register = func(ctx context.Context, m *http.ServeMux) error {
+		mux = m
+		return opts.Register(ctx, m)
+	}
+	if _, err := http_server.NewStdHTTPRuntime(ctx, cfg, std); err != nil {
+		return nil, err
+	}
+
+	var h http.Handler = mux
+	if cfg.CORS.Enabled {
+		h = corsMiddleware(h)
+	}
+
+	addr := net.JoinHostPort(cfg.Host, fmt.Sprintf("%d", cfg.Port))
+	if opts.Logger != nil {
+		opts.Logger("HTTP server uses TLS", "addr", addr)
+	}
+	return &httpsRuntime{
+		cfg:  cfg,
+		opts: opts,
+		srv: &http.Server{
+			Addr:      addr,
+			Handler:   h,
+			TLSConfig: tlsConf,
+		},
+	}, nil
+}
+
+func (r *httpsRuntime) ListenAndServe(ctx context.Context) error {
+	go func() {
+		<-ctx.Done()
+		_ = r.Shutdown(context.Background())
+	}()
+
+	// The certificate comes from TLSConfig.
+	err := r.srv.ListenAndServeTLS("", "")
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (r *httpsRuntime) Shutdown(ctx context.Context) error {
+	timeout := r.cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if r.opts.Logger != nil {
+		r.opts.Logger("Shutting down HTTP server",
+			"addr", r.srv.Addr,
+			"timeout", timeout.String(),
+		)
+	}
+	return r.srv.Shutdown(shutdownCtx)
+}
+
+// corsMiddleware matches the one of the standard runtime.
+func corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
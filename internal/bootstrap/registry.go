@@ -5,27 +5,56 @@ import (
 	"net/http"
 
 	Geocoder "github.com/Elessarov1/geocoder-go"
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+	"github.com/Elessarov1/geocoder-go/internal/certs"
 	"github.com/Elessarov1/geocoder-go/internal/common/logger"
+	"github.com/Elessarov1/geocoder-go/internal/config"
 	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
 	"github.com/Elessarov1/geocoder-go/internal/gprc_server"
 	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+	"github.com/Elessarov1/geocoder-go/internal/ratelimit"
 	"github.com/Elessarov1/geocoder-go/internal/server"
+	"github.com/Elessarov1/geocoder-go/internal/server/middleware"
 	"github.com/Elessarov1/geocoder-go/internal/server/oas"
 	kit_grpc "github.com/Elessarov1/service-kit/component/grpc"
 	http_server "github.com/Elessarov1/service-kit/component/server"
 	kitcore "github.com/Elessarov1/service-kit/core"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
-func Registry(api *geocoder_api.Service) kitcore.Registry {
+// Security guards the servers. The zero value leaves them open and on
+// plaintext.
+type Security struct {
+	// Keys, if set, are required by both servers.
+	Keys *auth.Keyring
+	// HTTPTLS and GRPCTLS, if set, serve the listeners over TLS.
+	HTTPTLS *certs.Reloader
+	GRPCTLS *certs.Reloader
+}
+
+// Registry builds the HTTP and gRPC components. Both share the limits on
+// heavy operations.
+func Registry(api *geocoder_api.Service, cfg config.Config, sec Security) kitcore.Registry {
+	limiter := ratelimit.NewLimiter(cfg.Limits.ClientRate, cfg.Limits.ClientBurst, cfg.Limits.MaxInFlight)
+
+	httpOpts := httpServer(api, cfg, sec.Keys, limiter)
+	httpModule := http_server.StdModule(httpOpts)
+	if sec.HTTPTLS != nil {
+		tlsConf := sec.HTTPTLS.TLSConfig("h2", "http/1.1")
+		httpModule = http_server.Module(func(ctx context.Context, c http_server.Config) (http_server.Runtime, error) {
+			return newHTTPSRuntime(ctx, c, httpOpts, tlsConf)
+		})
+	}
+
 	return kitcore.NewRegistry(
-		http_server.StdModule(httpServer(api)),
-		kit_grpc.StdModule(grpcServer(api)),
+		httpModule,
+		kit_grpc.StdModule(grpcServer(api, sec.Keys, sec.GRPCTLS, limiter)),
 	)
 }
 
-func httpServer(api *geocoder_api.Service) http_server.StdOptions {
+func httpServer(api *geocoder_api.Service, cfg config.Config, keys *auth.Keyring, limiter *ratelimit.Limiter) http_server.StdOptions {
 	// We'll capture the service logger from ctx during Register().
 	var lg *zap.SugaredLogger
 
@@ -40,7 +69,26 @@ func httpServer(api *geocoder_api.Service) http_server.StdOptions {
 			if err != nil {
 				return err
 			}
-			mux.Handle("/", oasServer)
+
+			clientIP, err := middleware.NewClientIPResolver(cfg.Proxy.TrustedCIDRs, cfg.Proxy.Headers)
+			if err != nil {
+				return err
+			}
+
+			operation := func(r *http.Request) string {
+				route, _ := oasServer.FindPath(r.Method, r.URL)
+				return route.OperationID()
+			}
+
+			var handler http.Handler = oasServer
+			handler = middleware.Wrap(handler, middleware.LimitMiddleware(limiter, operation))
+			if keys != nil {
+				handler = middleware.Wrap(handler, middleware.AuthMiddleware(keys, operation))
+			}
+			handler = middleware.Wrap(handler, middleware.LoggerMiddleware(lg.Desugar(), false))
+			handler = middleware.Wrap(handler, middleware.ClientIPMiddleware(clientIP))
+
+			mux.Handle("/", handler)
 			return nil
 		},
 
@@ -58,8 +106,19 @@ func httpServer(api *geocoder_api.Service) http_server.StdOptions {
 	}
 }
 
-func grpcServer(api geocoder_api.API) kit_grpc.StdOptions {
+func grpcServer(api geocoder_api.API, keys *auth.Keyring, tlsFiles *certs.Reloader, limiter *ratelimit.Limiter) kit_grpc.StdOptions {
 	var lg *zap.SugaredLogger
+	getLogger := func() *zap.Logger { return lg.Desugar() }
+
+	var opts []grpc.ServerOption
+	if tlsFiles != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsFiles.TLSConfig("h2"))))
+	}
+	// Interceptors run in order: authentication first, so limits see the key.
+	if keys != nil {
+		opts = append(opts, grpc_server.AuthOptions(keys, getLogger)...)
+	}
+	opts = append(opts, grpc_server.LimitOptions(limiter, getLogger)...)
 
 	return kit_grpc.StdOptions{
 		Register: func(ctx context.Context, s *grpc.Server) error {
@@ -70,10 +129,9 @@ func grpcServer(api geocoder_api.API) kit_grpc.StdOptions {
 			return nil
 		},
 
-		ServerOptions: []grpc.ServerOption{
-			// Optional: interceptors, creds, etc.
-			// grpc.UnaryInterceptor(unaryLoggingInterceptor(lg.Desugar())),
-		},
+		// Optional: more interceptors, creds, etc.
+		// grpc.UnaryInterceptor(unaryLoggingInterceptor(lg.Desugar())),
+		ServerOptions: opts,
 
 		Logger: func(msg string, kv ...any) {
 			if lg != nil {
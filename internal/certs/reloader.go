@@ -0,0 +1,165 @@
+// Package certs serves TLS certificates from disk and picks up rotated
+// files without a restart.
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Reloader holds a certificate, its key and an optional client CA bundle
+// read from disk. On a TLS handshake, at most once per interval, it checks
+// the files and reloads them if they changed. A failed reload (say, a
+// certificate written before its key) keeps the previous files in use and
+// is retried on the next check.
+type Reloader struct {
+	certFile, keyFile, caFile string
+	clientAuth                tls.ClientAuthType
+	interval                  time.Duration
+	lg                        *zap.Logger
+
+	mu      sync.Mutex
+	checked time.Time
+	stamps  []fileStamp
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+}
+
+type fileStamp struct {
+	mod  time.Time
+	size int64
+}
+
+// NewReloader loads the files. With caFile, clients must present a
+// certificate signed by one of its CAs, or may present one when optional is
+// set.
+func NewReloader(certFile, keyFile, caFile string, optional bool, interval time.Duration, lg *zap.Logger) (*Reloader, error) {
+	r := &Reloader{
+		certFile:   certFile,
+		keyFile:    keyFile,
+		caFile:     caFile,
+		clientAuth: tls.NoClientCert,
+		interval:   interval,
+		lg:         lg,
+	}
+	if caFile != "" {
+		r.clientAuth = tls.RequireAndVerifyClientCert
+		if optional {
+			r.clientAuth = tls.VerifyClientCertIfGiven
+		}
+	}
+
+	stamps, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(stamps); err != nil {
+		return nil, err
+	}
+	r.checked = time.Now()
+	return r, nil
+}
+
+// TLSConfig returns a server config that always uses the current files.
+// nextProtos are the ALPN protocols offered: "h2" for gRPC, "h2" and
+// "http/1.1" for HTTP.
+func (r *Reloader) TLSConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.current(time.Now())
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    pool,
+				NextProtos:   nextProtos,
+			}, nil
+		},
+	}
+}
+
+func (r *Reloader) current(now time.Time) (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.checked) >= r.interval {
+		r.checked = now
+		if err := r.reload(); err != nil {
+			r.lg.Warn("TLS files not reloaded, keeping the previous ones",
+				zap.String("cert", r.certFile), zap.Error(err))
+		}
+	}
+	return r.cert, r.pool
+}
+
+// reload loads the files if any of them changed since the last load.
+func (r *Reloader) reload() error {
+	stamps, err := r.stat()
+	if err != nil {
+		return err
+	}
+	changed := false
+	for i := range stamps {
+		if stamps[i] != r.stamps[i] {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := r.load(stamps); err != nil {
+		return err
+	}
+	r.lg.Info("TLS files reloaded", zap.String("cert", r.certFile))
+	return nil
+}
+
+func (r *Reloader) files() []string {
+	if r.caFile == "" {
+		return []string{r.certFile, r.keyFile}
+	}
+	return []string{r.certFile, r.keyFile, r.caFile}
+}
+
+func (r *Reloader) stat() ([]fileStamp, error) {
+	files := r.files()
+	stamps := make([]fileStamp, len(files))
+	for i, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, err
+		}
+		stamps[i] = fileStamp{mod: fi.ModTime(), size: fi.Size()}
+	}
+	return stamps, nil
+}
+
+func (r *Reloader) load(stamps []fileStamp) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load key pair %s: %w", r.certFile, err)
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.New("no certificates in client CA file " + r.caFile)
+		}
+	}
+
+	r.cert, r.pool, r.stamps = &cert, pool, stamps
+	return nil
+}
@@ -0,0 +1,234 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// testCA issues certificates for the tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key for cn, usable by servers and
+// clients alike.
+func (ca *testCA) issue(t *testing.T, cn string, serial int64) (certPEM, keyPEM []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"Example"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kb, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kb})
+}
+
+// writeFile writes a file with the given modification time, so rewrites are
+// seen as changes whatever the file system's time resolution.
+func writeFile(t *testing.T, path string, b []byte, mod time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, b, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, mod, mod); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func commonName(t *testing.T, c *tls.Certificate) string {
+	t.Helper()
+	leaf, err := x509.ParseCertificate(c.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestReloaderRotation(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	mod := time.Now().Add(-time.Hour)
+	c1, k1 := ca.issue(t, "first", 2)
+	writeFile(t, certFile, c1, mod)
+	writeFile(t, keyFile, k1, mod)
+
+	r, err := NewReloader(certFile, keyFile, "", false, time.Minute, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewReloader: %v", err)
+	}
+	start := r.checked
+
+	// Half-written pair: the new certificate without its key.
+	c2, k2 := ca.issue(t, "second", 3)
+	writeFile(t, certFile, c2, mod.Add(time.Minute))
+
+	if cert, _ := r.current(start.Add(time.Minute)); commonName(t, cert) != "first" {
+		t.Errorf("after a half-written pair: serving %q, want first", commonName(t, cert))
+	}
+
+	writeFile(t, keyFile, k2, mod.Add(time.Minute))
+
+	// Not checked again before the interval has passed.
+	if cert, _ := r.current(start.Add(time.Minute + time.Second)); commonName(t, cert) != "first" {
+		t.Errorf("within the interval: serving %q, want first", commonName(t, cert))
+	}
+	if cert, _ := r.current(start.Add(2 * time.Minute)); commonName(t, cert) != "second" {
+		t.Errorf("after the interval: serving %q, want second", commonName(t, cert))
+	}
+}
+
+func TestReloaderClientAuth(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.pem")
+	c, k := ca.issue(t, "server", 2)
+	writeFile(t, certFile, c, time.Now())
+	writeFile(t, keyFile, k, time.Now())
+	writeFile(t, caFile, ca.pem, time.Now())
+
+	clientPEM, clientKeyPEM := ca.issue(t, "billing", 3)
+	clientCert, err := tls.X509KeyPair(clientPEM, clientKeyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	tests := []struct {
+		name     string
+		caFile   string
+		optional bool
+		want     tls.ClientAuthType
+	}{
+		{"no client CA", "", false, tls.NoClientCert},
+		{"require", caFile, false, tls.RequireAndVerifyClientCert},
+		{"optional", caFile, true, tls.VerifyClientCertIfGiven},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReloader(certFile, keyFile, tt.caFile, tt.optional, time.Minute, zap.NewNop())
+			if err != nil {
+				t.Fatalf("NewReloader: %v", err)
+			}
+			cfg, err := r.TLSConfig("h2").GetConfigForClient(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.ClientAuth != tt.want {
+				t.Errorf("ClientAuth = %s, want %s", cfg.ClientAuth, tt.want)
+			}
+
+			ln, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer ln.Close()
+
+			// handshake returns the client subject the server verified. The
+			// server's error is the one that counts: under TLS 1.3 the client
+			// is done before its certificate is checked.
+			handshake := func(certs ...tls.Certificate) (string, error) {
+				type result struct {
+					subject string
+					err     error
+				}
+				done := make(chan result, 1)
+				go func() {
+					conn, err := ln.Accept()
+					if err != nil {
+						done <- result{err: err}
+						return
+					}
+					defer conn.Close()
+					srv := tls.Server(conn, r.TLSConfig("h2"))
+					if err := srv.Handshake(); err != nil {
+						done <- result{err: err}
+						return
+					}
+					var subject string
+					if chains := srv.ConnectionState().VerifiedChains; len(chains) > 0 {
+						subject = chains[0][0].Subject.String()
+					}
+					done <- result{subject: subject}
+				}()
+
+				cl, err := tls.Dial("tcp", ln.Addr().String(), &tls.Config{RootCAs: roots, Certificates: certs})
+				if err == nil {
+					cl.Close()
+				}
+				res := <-done
+				return res.subject, res.err
+			}
+
+			subject, err := handshake()
+			if (tt.want == tls.RequireAndVerifyClientCert) != (err != nil) {
+				t.Errorf("handshake without a client certificate: %v", err)
+			}
+			if tt.caFile != "" {
+				subject, err = handshake(clientCert)
+				if err != nil || subject != "CN=billing,O=Example" {
+					t.Errorf("handshake with a client certificate: subject %q, %v", subject, err)
+				}
+			}
+		})
+	}
+}
@@ -1,15 +1,163 @@
 package config
 
-import "github.com/Elessarov1/service-kit/component/server"
-import "github.com/Elessarov1/service-kit/component/grpc"
+import (
+	"time"
+
+	"github.com/Elessarov1/service-kit/component/grpc"
+	"github.com/Elessarov1/service-kit/component/server"
+)
 
 type Config struct {
 	GeoCoder GeoCoderConfig
+	Proxy    ProxyConfig
+	Auth     AuthConfig
+	Limits   LimitsConfig
 	Server   server.Config
 	GRPC     grpc.Config
+
+	// ServerTLS and GRPCTLS are the tls keys of the server and grpc sections
+	// of config.yml.
+	ServerTLS TLSConfig `yaml:"-" envPrefix:"GEOCODER_SERVER_TLS_"`
+	GRPCTLS   TLSConfig `yaml:"-" envPrefix:"GEOCODER_GRPC_TLS_"`
+
+	// Groups are custom country groups from the groups section of
+	// config.yml, usable wherever ISO codes are accepted.
+	Groups []GroupConfig `env:"-" yaml:"groups" validate:"dive"`
+}
+
+// GroupConfig defines a custom country group. Members are ISO codes or IDs
+// of other groups, built-in (EU, EUROPE, ...) or custom.
+type GroupConfig struct {
+	ID      string   `yaml:"id" validate:"required"`
+	Name    string   `yaml:"name"`
+	Members []string `yaml:"members" validate:"required,min=1,dive,required"`
 }
 
+// Data sources.
+const (
+	SourceMMDB = "mmdb"
+	SourceRIR  = "rir"
+	SourceCSV  = "csv"
+
+	// SourceLayered merges the sources listed in Layers.
+	SourceLayered = "layered"
+)
+
 type GeoCoderConfig struct {
+	// Source selects the loader: mmdb (GeoIPDbPath), rir (RIRFiles),
+	// csv (CSVBlocks + CSVLocations) or layered (Layers).
+	Source      string `env:"GEOCODER_SOURCE" default:"mmdb" validate:"oneof=mmdb rir csv layered"`
 	GeoIPDbPath string `env:"GEOIP_DATABASE_PATH" default:"db/RU-GeoIP-Country.mmdb"`
-	Debug       bool   `env:"GEOCODER_DEBUG" default:"false"`
+	// Layers lists the sources merged by the layered loader, highest
+	// precedence first; each one uses its own settings above.
+	Layers []string `env:"GEOCODER_LAYERS" validate:"required_if=Source layered,unique,dive,oneof=mmdb rir csv"`
+	// RIRFiles are delegated(-extended) statistics files or glob patterns.
+	RIRFiles []string `env:"GEOCODER_RIR_FILES" validate:"required_if=Source rir"`
+	// CSVFormat is geolite2 (Blocks-IPv4/IPv6 + Locations) or dbip (lite CSV).
+	CSVFormat    string   `env:"GEOCODER_CSV_FORMAT" default:"geolite2" validate:"oneof=geolite2 dbip"`
+	CSVBlocks    []string `env:"GEOCODER_CSV_BLOCKS" validate:"required_if=Source csv"`
+	CSVLocations string   `env:"GEOCODER_CSV_LOCATIONS"`
+	Debug        bool     `env:"GEOCODER_DEBUG" default:"false"`
+	// OverrideFiles are CSV/YAML files of CIDR -> ISO code corrections applied
+	// on top of the database, in order (later files win on equal prefixes).
+	OverrideFiles []string `env:"GEOCODER_OVERRIDE_FILES"`
+	// SnapshotPath caches the built store on disk; it is reused on the next
+	// start while the source files (and overrides) are unchanged. Empty
+	// disables snapshots.
+	SnapshotPath string `env:"GEOCODER_SNAPSHOT_PATH"`
+	// AdminEnabled exposes diagnostic endpoints such as /geo/explain.
+	AdminEnabled bool `env:"GEOCODER_ADMIN_ENABLED" default:"false"`
+
+	// Loader is the geocoder section of config.yml.
+	Loader LoaderConfig `yaml:"geocoder"`
+}
+
+// Address families the loader keeps.
+const (
+	FamilyAll  = "all"
+	FamilyIPv4 = "ipv4"
+	FamilyIPv6 = "ipv6"
+)
+
+// LoaderConfig controls how source records become networks and how
+// addresses are looked up. It is read from the geocoder section of
+// config.yml; environment variables take precedence.
+type LoaderConfig struct {
+	// UnknownISO is the code of networks and addresses without a country.
+	UnknownISO string `yaml:"unknown_iso" env:"GEOCODER_UNKNOWN_ISO" default:"ZZ" validate:"len=2,alpha,uppercase"`
+	// SkipAliasedNetworks leaves out the IPv6 ranges of an MMDB that alias
+	// the IPv4 tree (::ffff:0:0/96, 2002::/16, 2001::/32).
+	SkipAliasedNetworks bool `yaml:"skip_aliased_networks" env:"GEOCODER_SKIP_ALIASED_NETWORKS" default:"true"`
+	// CountryPreference lists the record fields a network's country is
+	// taken from, first non-empty wins; fields left out are never used.
+	CountryPreference []string `yaml:"country_preference" env:"GEOCODER_COUNTRY_PREFERENCE" default:"[\"country\",\"registered_country\",\"represented_country\"]" validate:"min=1,unique,dive,oneof=country registered_country represented_country"`
+	// IncludeUnknown lists networks without a country under UnknownISO;
+	// otherwise they are dropped at load and only lookups answer UnknownISO.
+	IncludeUnknown bool `yaml:"include_unknown" env:"GEOCODER_INCLUDE_UNKNOWN" default:"true"`
+	// Family loads both address families (all) or only ipv4 or ipv6.
+	Family string `yaml:"family" env:"GEOCODER_FAMILY" default:"all" validate:"oneof=all ipv4 ipv6"`
+	// UnwrapAliases lists the tunnel addresses looked up by the IPv4
+	// address they embed: 6to4 (2002::/16) and teredo (2001::/32).
+	// IPv4-mapped addresses (::ffff:a.b.c.d) always are.
+	UnwrapAliases []string `yaml:"unwrap_aliases" env:"GEOCODER_UNWRAP_ALIASES" default:"[\"6to4\",\"teredo\"]" validate:"unique,dive,oneof=6to4 teredo"`
+	// IPInput lists the address spellings accepted besides plain
+	// addresses: port (1.2.3.4:5678, [2001:db8::1]:443), brackets
+	// ([2001:db8::1]), zone (fe80::1%eth0), integer (16909060) and cidr
+	// (looked up by the network address).
+	IPInput []string `yaml:"ip_input" env:"GEOCODER_IP_INPUT" default:"[\"port\",\"brackets\",\"zone\",\"integer\"]" validate:"unique,dive,oneof=port brackets zone integer cidr"`
+}
+
+// ProxyConfig controls how the HTTP server resolves the real client IP. It
+// is read from the proxy section of config.yml; environment variables take
+// precedence.
+//
+// Forwarding headers are honored only when the direct peer (and every hop
+// walked through) belongs to TrustedCIDRs; with no trusted proxies the socket
+// address is always used. Headers are tried in order and the first one
+// holding an address wins, so list only headers the trusted proxies set or
+// overwrite: a header they pass through unchanged can be forged by clients.
+// Most proxies only append to X-Forwarded-For, hence the default.
+type ProxyConfig struct {
+	TrustedCIDRs []string `yaml:"trusted_cidrs" env:"GEOCODER_TRUSTED_PROXIES" validate:"dive,cidr|ip"`
+	Headers      []string `yaml:"headers" env:"GEOCODER_CLIENT_IP_HEADERS" default:"[\"x-forwarded-for\"]" validate:"dive,oneof=forwarded x-forwarded-for x-real-ip true-client-ip"`
+}
+
+// AuthConfig enables API key authentication on both servers.
+type AuthConfig struct {
+	// KeysFile is a YAML file of API keys with hashed secrets, scopes and
+	// rate limits; empty leaves both servers open.
+	KeysFile string `env:"GEOCODER_API_KEYS_FILE"`
+}
+
+// LimitsConfig limits the heavy operations (full network lists, streams and
+// exports) on both servers. Zero disables a limit.
+type LimitsConfig struct {
+	// ClientRate is the heavy requests per second allowed per client (API
+	// key, or client IP without one); ClientBurst the requests allowed at
+	// once, by default the rate rounded up.
+	ClientRate  float64 `env:"GEOCODER_HEAVY_CLIENT_RATE" validate:"gte=0"`
+	ClientBurst int     `env:"GEOCODER_HEAVY_CLIENT_BURST" validate:"gte=0"`
+	// MaxInFlight caps the heavy requests running at once across clients
+	// and both servers.
+	MaxInFlight int `env:"GEOCODER_HEAVY_MAX_IN_FLIGHT" default:"8" validate:"gte=0"`
+}
+
+// Client certificate policies with a client CA.
+const (
+	ClientAuthRequire  = "require"
+	ClientAuthOptional = "optional"
+)
+
+// TLSConfig enables TLS on a listener. Environment variables (with the
+// listener's prefix) take precedence over config.yml.
+type TLSConfig struct {
+	Enabled  bool   `yaml:"enabled" env:"ENABLED"`
+	CertFile string `yaml:"cert_file" env:"CERT_FILE" validate:"required_if=Enabled true"`
+	KeyFile  string `yaml:"key_file" env:"KEY_FILE" validate:"required_if=Enabled true"`
+	// ClientCAFile enables mTLS: clients present a certificate signed by
+	// one of these CAs, always (require) or if they have one (optional).
+	ClientCAFile string `yaml:"client_ca_file" env:"CLIENT_CA_FILE"`
+	ClientAuth   string `yaml:"client_auth" env:"CLIENT_AUTH" default:"require" validate:"oneof=require optional"`
+	// ReloadInterval is how often the files are checked for rotation.
+	ReloadInterval time.Duration `yaml:"reload_interval" env:"RELOAD_INTERVAL" default:"1m" validate:"gt=0"`
 }
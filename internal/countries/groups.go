@@ -0,0 +1,214 @@
+// Package countries holds country metadata that does not depend on the
+// loaded dataset: the ISO 3166-1 catalogue and groups of countries such as
+// continents and unions.
+package countries
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Group kinds.
+const (
+	KindContinent = "continent"
+	KindUnion     = "union" // political and economic unions
+	KindCustom    = "custom"
+)
+
+// Group is a named set of ISO 3166-1 alpha-2 codes.
+type Group struct {
+	ID      string
+	Name    string
+	Kind    string
+	Members []string // sorted, nested groups expanded
+}
+
+// GroupDef defines a custom group. Members are ISO alpha-2 or alpha-3 codes
+// or IDs of other groups (built-in or custom).
+type GroupDef struct {
+	ID      string
+	Name    string
+	Members []string
+}
+
+var unions = []GroupDef{
+	{ID: "EU", Name: "European Union", Members: strings.Fields("AT BE BG CY CZ DE DK EE ES FI FR GR HR HU IE IT LT LU LV MT NL PL PT RO SE SI SK")},
+	{ID: "EEA", Name: "European Economic Area", Members: strings.Fields("EU IS LI NO")},
+	{ID: "EFTA", Name: "European Free Trade Association", Members: strings.Fields("CH IS LI NO")},
+	{ID: "SCHENGEN", Name: "Schengen Area", Members: strings.Fields("AT BE BG CH CZ DE DK EE ES FI FR GR HR HU IS IT LI LT LU LV MT NL NO PL PT RO SE SI SK")},
+	{ID: "CIS", Name: "Commonwealth of Independent States", Members: strings.Fields("AM AZ BY KG KZ MD RU TJ UZ")},
+	{ID: "EAEU", Name: "Eurasian Economic Union", Members: strings.Fields("AM BY KG KZ RU")},
+	{ID: "NORDIC", Name: "Nordic countries", Members: strings.Fields("DK FI IS NO SE")},
+	{ID: "G7", Name: "Group of Seven", Members: strings.Fields("CA DE FR GB IT JP US")},
+	{ID: "ASEAN", Name: "Association of Southeast Asian Nations", Members: strings.Fields("BN ID KH LA MM MY PH SG TH TL VN")},
+	{ID: "GCC", Name: "Gulf Cooperation Council", Members: strings.Fields("AE BH KW OM QA SA")},
+}
+
+var (
+	isoRe = regexp.MustCompile(`^[A-Z]{2}$`)
+	// Custom IDs are longer than alpha-2 codes and must not be alpha-3 ones,
+	// so they never shadow a country.
+	customIDRe = regexp.MustCompile(`^[A-Z][A-Z0-9_]{2,31}$`)
+)
+
+// Groups is the catalogue of built-in and custom groups.
+type Groups struct {
+	byID  map[string]Group
+	order []string // built-in groups first, then custom ones in config order
+}
+
+// NewGroups returns the built-in groups plus custom. Custom IDs must not
+// clash with built-in ones; members may reference any group as long as the
+// references do not form a cycle.
+func NewGroups(custom []GroupDef) (*Groups, error) {
+	defs := make(map[string]GroupDef)
+	kinds := make(map[string]string)
+	g := &Groups{byID: make(map[string]Group)}
+
+	add := func(d GroupDef, kind string) error {
+		if _, dup := defs[d.ID]; dup {
+			return fmt.Errorf("group %s is defined twice", d.ID)
+		}
+		defs[d.ID] = d
+		kinds[d.ID] = kind
+		g.order = append(g.order, d.ID)
+		return nil
+	}
+	for _, c := range continentGroups() {
+		_ = add(c, KindContinent)
+	}
+	for _, u := range unions {
+		_ = add(u, KindUnion)
+	}
+	for _, d := range custom {
+		d.ID = strings.ToUpper(strings.TrimSpace(d.ID))
+		if !customIDRe.MatchString(d.ID) {
+			return nil, fmt.Errorf("invalid group id %q: want 3-32 letters, digits or '_', starting with a letter", d.ID)
+		}
+		if _, ok := byAlpha3[d.ID]; ok {
+			return nil, fmt.Errorf("invalid group id %q: it is an ISO 3166 alpha-3 code", d.ID)
+		}
+		if len(d.Members) == 0 {
+			return nil, fmt.Errorf("group %s has no members", d.ID)
+		}
+		members := make([]string, len(d.Members))
+		for i, m := range d.Members {
+			members[i] = strings.ToUpper(strings.TrimSpace(m))
+		}
+		d.Members = members
+		if err := add(d, KindCustom); err != nil {
+			return nil, err
+		}
+	}
+
+	// Expand nested groups; visiting marks groups on the current path.
+	visiting := make(map[string]bool)
+	var expand func(id string) ([]string, error)
+	expand = func(id string) ([]string, error) {
+		if grp, ok := g.byID[id]; ok {
+			return grp.Members, nil
+		}
+		if visiting[id] {
+			return nil, fmt.Errorf("group %s references itself", id)
+		}
+		visiting[id] = true
+		defer delete(visiting, id)
+
+		var out []string
+		for _, m := range defs[id].Members {
+			if defs[m].ID == "" {
+				m = Alpha2(m)
+			}
+			switch {
+			case isoRe.MatchString(m) && defs[m].ID == "":
+				out = append(out, m)
+			case defs[m].ID != "":
+				sub, err := expand(m)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, sub...)
+			default:
+				return nil, fmt.Errorf("group %s: unknown member %q", id, m)
+			}
+		}
+		slices.Sort(out)
+		out = slices.Compact(out)
+
+		d := defs[id]
+		g.byID[id] = Group{ID: id, Name: d.Name, Kind: kinds[id], Members: out}
+		return out, nil
+	}
+	for _, id := range g.order {
+		if _, err := expand(id); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// continentGroups groups the catalogue by continent, in ID order.
+func continentGroups() []GroupDef {
+	var out []GroupDef
+	for _, c := range Countries() {
+		id := strings.ToUpper(strings.ReplaceAll(c.Continent, " ", "_"))
+		i := slices.IndexFunc(out, func(d GroupDef) bool { return d.ID == id })
+		if i < 0 {
+			i = len(out)
+			out = append(out, GroupDef{ID: id, Name: c.Continent})
+		}
+		out[i].Members = append(out[i].Members, c.Alpha2)
+	}
+	slices.SortFunc(out, func(a, b GroupDef) int { return strings.Compare(a.ID, b.ID) })
+	return out
+}
+
+// Builtin returns the catalogue without custom groups.
+func Builtin() *Groups {
+	g, err := NewGroups(nil)
+	if err != nil {
+		panic(err) // the built-in tables are broken
+	}
+	return g
+}
+
+// Lookup finds a group by ID, case-insensitively.
+func (g *Groups) Lookup(id string) (Group, bool) {
+	grp, ok := g.byID[strings.ToUpper(strings.TrimSpace(id))]
+	return grp, ok
+}
+
+// All returns every group, built-in ones first.
+func (g *Groups) All() []Group {
+	out := make([]Group, 0, len(g.order))
+	for _, id := range g.order {
+		out = append(out, g.byID[id])
+	}
+	return out
+}
+
+// Expand replaces group IDs in codes by their members and alpha-3 codes by
+// alpha-2 ones, dropping duplicates but keeping the first-seen order.
+func (g *Groups) Expand(codes []string) []string {
+	out := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	push := func(c string) {
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	for _, c := range codes {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if grp, ok := g.byID[c]; ok {
+			for _, m := range grp.Members {
+				push(m)
+			}
+			continue
+		}
+		push(Alpha2(c))
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package countries
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// Country is an ISO 3166-1 entry. XK (Kosovo) is not assigned by ISO but
+// is used by the registries and MaxMind, so it is listed too.
+type Country struct {
+	Alpha2    string
+	Alpha3    string
+	Numeric   string // three digits; "" for XK
+	Name      string // English short name
+	Continent string // as in the continent groups
+	Region    string // UN M49 sub-region; "" for Antarctica
+}
+
+//go:embed iso3166.csv
+var iso3166CSV string
+
+var (
+	catalogue []Country // sorted by Alpha2
+	byAlpha2  = make(map[string]int)
+	byAlpha3  = make(map[string]int)
+)
+
+func init() {
+	rows, err := csv.NewReader(strings.NewReader(iso3166CSV)).ReadAll()
+	if err != nil {
+		panic(fmt.Errorf("iso3166.csv: %w", err))
+	}
+	for _, r := range rows[1:] {
+		c := Country{Alpha2: r[0], Alpha3: r[1], Numeric: r[2], Name: r[3], Continent: r[4], Region: r[5]}
+		byAlpha2[c.Alpha2] = len(catalogue)
+		byAlpha3[c.Alpha3] = len(catalogue)
+		catalogue = append(catalogue, c)
+	}
+}
+
+// Countries returns the catalogue, sorted by alpha-2 code.
+func Countries() []Country {
+	return catalogue
+}
+
+// ByCode finds a country by its alpha-2 or alpha-3 code, case-insensitively.
+func ByCode(code string) (Country, bool) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	i, ok := byAlpha2[code]
+	if !ok {
+		i, ok = byAlpha3[code]
+	}
+	if !ok {
+		return Country{}, false
+	}
+	return catalogue[i], true
+}
+
+// Alpha2 upper-cases code and turns an alpha-3 code into its alpha-2 one.
+// Anything else, including codes missing from the catalogue such as ZZ, is
+// returned upper-cased.
+func Alpha2(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if i, ok := byAlpha3[code]; ok {
+		return catalogue[i].Alpha2
+	}
+	return code
+}
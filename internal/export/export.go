@@ -0,0 +1,127 @@
+// Package export renders network lists in formats firewalls and proxies
+// load directly.
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatText     Format = "text"     // one CIDR per line
+	FormatNginx    Format = "nginx"    // geo block: $name is 1 for listed networks
+	FormatIPSet    Format = "ipset"    // ipset restore script, one set per family
+	FormatNFTables Format = "nftables" // nft defines, one per family
+)
+
+// DefaultName names the exported set when the caller gives none.
+const DefaultName = "geocoder"
+
+func Formats() []Format {
+	return []Format{FormatText, FormatNginx, FormatIPSet, FormatNFTables}
+}
+
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats() {
+		if strings.EqualFold(s, string(f)) {
+			return f, nil
+		}
+	}
+	names := make([]string, 0, len(Formats()))
+	for _, f := range Formats() {
+		names = append(names, string(f))
+	}
+	return "", fmt.Errorf("unknown export format %q (want one of %s)", s, strings.Join(names, ", "))
+}
+
+// ipset set names are at most 31 characters, including the "-v4" suffix.
+var nameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,27}$`)
+
+// CheckName reports whether name can be used as a set or variable name in
+// every format.
+func CheckName(name string) error {
+	if !nameRe.MatchString(name) {
+		return fmt.Errorf("invalid export name %q: want a letter or '_' followed by up to 27 letters, digits or '_'", name)
+	}
+	return nil
+}
+
+// Write renders prefixes in format f. name is the nginx variable, ipset set
+// or nft define the networks are put in; "" means DefaultName.
+func Write(w io.Writer, f Format, name string, prefixes []netip.Prefix) error {
+	if name == "" {
+		name = DefaultName
+	}
+	if err := CheckName(name); err != nil {
+		return err
+	}
+
+	var v4, v6 []netip.Prefix
+	for _, p := range prefixes {
+		if p.Addr().Is4() {
+			v4 = append(v4, p)
+		} else {
+			v6 = append(v6, p)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	switch f {
+	case FormatText:
+		for _, p := range prefixes {
+			fmt.Fprintln(bw, p)
+		}
+
+	case FormatNginx:
+		fmt.Fprintf(bw, "geo $%s {\n\tdefault 0;\n", name)
+		for _, p := range prefixes {
+			fmt.Fprintf(bw, "\t%s 1;\n", p)
+		}
+		fmt.Fprintln(bw, "}")
+
+	case FormatIPSet:
+		for _, fam := range []struct {
+			suffix, family string
+			list           []netip.Prefix
+		}{{"v4", "inet", v4}, {"v6", "inet6", v6}} {
+			if len(fam.list) == 0 {
+				continue
+			}
+			set := name + "-" + fam.suffix
+			fmt.Fprintf(bw, "create %s hash:net family %s maxelem %d -exist\n", set, fam.family, max(65536, len(fam.list)))
+			for _, p := range fam.list {
+				fmt.Fprintf(bw, "add %s %s -exist\n", set, p)
+			}
+		}
+
+	case FormatNFTables:
+		// nft rejects an empty anonymous set, so empty families are left out.
+		for _, fam := range []struct {
+			suffix string
+			list   []netip.Prefix
+		}{{"v4", v4}, {"v6", v6}} {
+			if len(fam.list) == 0 {
+				continue
+			}
+			fmt.Fprintf(bw, "define %s_%s = {\n", name, fam.suffix)
+			for i, p := range fam.list {
+				sep := ","
+				if i == len(fam.list)-1 {
+					sep = ""
+				}
+				fmt.Fprintf(bw, "\t%s%s\n", p, sep)
+			}
+			fmt.Fprintln(bw, "}")
+		}
+
+	default:
+		return fmt.Errorf("unknown export format %q", f)
+	}
+	return bw.Flush()
+}
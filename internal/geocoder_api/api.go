@@ -2,7 +2,11 @@ package geocoder_api
 
 import (
 	"context"
+	"math/big"
 	"net/netip"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
 )
 
 type Health struct {
@@ -13,33 +17,319 @@ type Health struct {
 type CountryRangeData struct {
 	Code        string
 	RangesCount int
+
+	// Address space the country answers for; see AddressSpace.
+	IPv4Addresses uint64
+	IPv6Slash64s  uint64
+	IPv4Percent   float64
+	IPv6Percent   float64
 }
 
 type GeoIPData struct {
-	IP          string
-	Code        string
-	CountryName string
+	// IP is the address as given; NormalizedIP is what it was read as (see
+	// InputOptions).
+	IP           string
+	NormalizedIP netip.Addr
+	Code         string
+	CountryName  string
+
+	// Network is the matched database network; invalid when the address is
+	// not covered by the dataset.
+	Network netip.Prefix
+	// Source is the record field that produced Code
+	// (country, registered_country, represented_country or fallback).
+	Source            string
+	RegisteredCode    string
+	RegisteredDiffers bool
+	// Provenance is the dataset layer that answered (mmdb, rir, csv or
+	// overrides); "" when the address is not covered.
+	Provenance string
+
+	// AddressClass is the special-purpose class of the address looked up
+	// (private, loopback, documentation, ...), that is of LookupIP for an
+	// unwrapped alias; "none" for ordinary space. Special is the IANA
+	// registry entry behind it, nil for ordinary space.
+	AddressClass string
+	Special      *SpecialBlock
+	// Bogon marks addresses that are not globally reachable.
+	Bogon bool
+
+	// Alias is ipv4_mapped, 6to4 or teredo when the address was looked up
+	// by the IPv4 address it embeds, LookupIP; "" otherwise.
+	Alias    string
+	LookupIP netip.Addr
+}
+
+// SpecialBlock is an entry of the IANA special-purpose address registries.
+type SpecialBlock struct {
+	Network netip.Prefix
+	Name    string
+	RFC     string
 }
 
 type IsoCodeNetworks struct {
 	Code     string
-	Networks []netip.Prefix
+	Networks geoip.Ranges // read-only view into the store
+	// Provenance holds the layer of each network, index-aligned with
+	// Networks; nil unless requested.
+	Provenance []string
 }
 
 type PageData struct {
-	Content       []netip.Prefix
+	Content       geoip.Ranges // read-only view into the store
+	Provenance    []string     // index-aligned with Content; nil unless requested
 	TotalElements int
 	TotalPages    int
 	Page          int
 	Size          int
 }
 
+// AddressSpace is the part of one address family a country answers for.
+// Where networks nest the most specific one wins, as in lookups.
+type AddressSpace struct {
+	Networks  int
+	Addresses *big.Int
+	// Slash64s is Addresses in /64s, rounded down; IPv6 only.
+	Slash64s uint64
+	// Percent is the share of the family's space in the dataset.
+	Percent  float64
+	Prefixes []geoip.PrefixCount // prefix-length histogram, shortest first
+}
+
+// CountryInfo is the ISO 3166 entry of a country with its part of the
+// dataset. The ISO fields are empty for codes outside the catalogue, such
+// as ZZ.
+type CountryInfo struct {
+	Code      string // alpha-2
+	Alpha3    string
+	Numeric   string
+	Name      string
+	Continent string
+	Region    string // UN M49 sub-region
+
+	IPv4 AddressSpace
+	IPv6 AddressSpace
+}
+
+// CountryGroup is a named set of countries accepted in place of ISO codes.
+type CountryGroup struct {
+	ID      string
+	Name    string
+	Kind    string   // continent, union or custom
+	Members []string // ISO codes, sorted
+}
+
+// NetworkData is a dataset network with its country.
+type NetworkData struct {
+	Network    netip.Prefix
+	Code       string
+	Source     string // record field that produced Code
+	Provenance string // dataset layer the network came from
+}
+
+// CidrData answers the exact and containment queries for one CIDR.
+type CidrData struct {
+	CIDR      netip.Prefix  // as queried, host bits cleared
+	Exact     *NetworkData  // nil when the dataset has no such network
+	Supernets []NetworkData // networks containing CIDR, widest first
+
+	// One page of the networks inside CIDR, in address order.
+	Subnets      []NetworkData
+	TotalSubnets int
+	TotalPages   int
+	Page         int
+	Size         int
+}
+
+// CountryShare is the part of a queried range one country answers for.
+type CountryShare struct {
+	Code string
+	// Networks answering for the range, in address order; they may extend
+	// beyond it.
+	Networks  []netip.Prefix
+	Addresses *big.Int // addresses of the range
+	Percent   float64  // of the range
+}
+
+// RangeBreakdown splits one queried range or CIDR by country.
+type RangeBreakdown struct {
+	Query     string
+	Range     geoip.AddrRange
+	Addresses *big.Int
+	Countries []CountryShare // most addresses first
+
+	// Unallocated is the space no dataset network covers.
+	Unallocated          []geoip.AddrRange
+	UnallocatedAddresses *big.Int
+	UnallocatedPercent   float64
+}
+
+// ExpressionResult is an evaluated set expression.
+type ExpressionResult struct {
+	Expression string         // canonical form of the expression
+	Networks   []netip.Prefix // minimal CIDR list, IPv4 first
+
+	// Format is "json" or an export format; Export holds the networks
+	// rendered in it and is nil for JSON.
+	Format string
+	Export []byte
+}
+
+// BogonList is the bogon prefixes of one address family.
+type BogonList struct {
+	Family   string // ipv4 or ipv6
+	Networks []netip.Prefix
+
+	// Format is "json" or an export format; Export holds the networks
+	// rendered in it and is nil for JSON.
+	Format string
+	Export []byte
+}
+
+// QualityReport lists suspicious networks and countries of the loaded
+// dataset. The Total fields count everything; the lists are cut at the
+// requested limit.
+type QualityReport struct {
+	RegisteredDiffers      []NetworkIssue
+	TotalRegisteredDiffers int
+	Fallbacks              []NetworkIssue
+	TotalFallbacks         int
+	AliasConflicts         []AliasConflict
+	TotalAliasConflicts    int
+
+	// HasPrevious is false when no previous build is known to compare with.
+	HasPrevious  bool
+	Changes      []CountryChange // largest change first
+	TotalChanges int
+}
+
+// NetworkIssue is a dataset network the quality report flags.
+type NetworkIssue struct {
+	Network        netip.Prefix
+	Code           string
+	RegisteredCode string // set for networks whose registered country differs
+	Provenance     string // source layer
+}
+
+// AliasConflict is an IPv6 alias network (ipv4_mapped, 6to4, teredo) whose
+// code differs from the IPv4 network it embeds.
+type AliasConflict struct {
+	Network  netip.Prefix
+	Code     string
+	Alias    string
+	IPv4     netip.Prefix
+	IPv4Code string
+}
+
+// CountryChange compares a country's counts with the previous build.
+type CountryChange struct {
+	Code          string
+	Before, After geoip.CountryCount
+	Percent       float64 // largest relative change of the counts
+}
+
+type NormalizeStep struct {
+	Field      string
+	Raw        string
+	Normalized string
+	Selected   bool
+}
+
+type DatasetInfo struct {
+	Type        string
+	BuildTime   time.Time
+	Description string
+}
+
+// SourceInfo is one layer of the loaded dataset.
+type SourceInfo struct {
+	Name     string // mmdb, rir, csv or overrides
+	Dataset  DatasetInfo
+	Networks int
+}
+
+// DatasetMetadata describes the loaded dataset and its layers, highest
+// precedence first.
+type DatasetMetadata struct {
+	Dataset DatasetInfo
+	Sources []SourceInfo
+}
+
+// OverrideInfo describes a local CIDR override that matched an address.
+type OverrideInfo struct {
+	Network netip.Prefix
+	Code    string
+	Comment string
+	Expires time.Time // zero: never
+	Origin  string
+}
+
+// IPExplanation is the full decision chain behind a single lookup.
+type IPExplanation struct {
+	IP string
+
+	Address       netip.Addr
+	Alias         string // none, ipv4_mapped, 6to4, teredo
+	EmbeddedIPv4  netip.Addr
+	AliasApplied  bool
+	LookupAddress netip.Addr
+
+	Found   bool
+	Network netip.Prefix
+	Record  map[string]any
+
+	// Database answer before overrides; Override is nil if none matched.
+	DatabaseNetwork netip.Prefix
+	DatabaseCode    string
+	Override        *OverrideInfo
+
+	Steps  []NormalizeStep
+	Code   string
+	Source string
+
+	Dataset DatasetInfo
+}
+
 type API interface {
 	Health(ctx context.Context) (Health, error)
 
 	GetCountries(ctx context.Context) ([]CountryRangeData, error)
-	GetIpData(ctx context.Context, ips []string) ([]GeoIPData, error)
+	// GetCountry accepts alpha-2 and alpha-3 codes.
+	GetCountry(ctx context.Context, code string) (CountryInfo, error)
+	// basis is "located" (or "") or "registered": the latter answers with
+	// registered_country where the record has one.
+	GetIpData(ctx context.Context, ips []string, basis string) ([]GeoIPData, error)
+
+	// isoCodes may be alpha-2 or alpha-3 codes or group IDs: GetCountryNetworks lists each member
+	// country, the paged variant pages through all members in turn. basis is as in GetIpData and
+	// lists networks under their registered country instead.
+	GetCountryNetworks(ctx context.Context, isoCodes []string, basis string, withProvenance bool) ([]IsoCodeNetworks, error)
+	GetCountryNetworksPaged(ctx context.Context, isoCode string, page, size int, basis string, withProvenance bool) (PageData, error)
+
+	GetGroups(ctx context.Context) ([]CountryGroup, error)
+
+	// GetCidrData pages through the subnets of every CIDR with the same page
+	// and size.
+	GetCidrData(ctx context.Context, cidrs []string, page, size int) ([]CidrData, error)
+
+	// GetRangeBreakdown accepts CIDRs, start-end ranges and single addresses.
+	GetRangeBreakdown(ctx context.Context, ranges []string) ([]RangeBreakdown, error)
+
+	// EvaluateExpression evaluates a set expression over ISO codes, groups
+	// and CIDRs (see geoip.ParseExpr). format is "json" (or "") or an export
+	// format; name names the exported set.
+	EvaluateExpression(ctx context.Context, expression, format, name string) (ExpressionResult, error)
+
+	// GetBogons lists the addresses of family ("ipv4" or "ipv6") that are
+	// not globally reachable; format and name are as in EvaluateExpression.
+	GetBogons(ctx context.Context, family, format, name string) (BogonList, error)
+
+	GetDataset(ctx context.Context) (DatasetMetadata, error)
+
+	// GetQualityReport checks the loaded dataset; zero arguments take the
+	// defaults of geoip.QualityOptions.
+	GetQualityReport(ctx context.Context, limit int, threshold float64, minNetworks int) (QualityReport, error)
 
-	GetCountryNetworks(ctx context.Context, isoCodes []string) ([]IsoCodeNetworks, error)
-	GetCountryNetworksPaged(ctx context.Context, isoCode string, page, size int) (PageData, error)
+	// ExplainIp is an admin-only diagnostic endpoint.
+	ExplainIp(ctx context.Context, ip string) (IPExplanation, error)
 }
@@ -0,0 +1,29 @@
+package geocoder_api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) GetBogons(_ context.Context, family, format, name string) (BogonList, error) {
+	family = strings.ToLower(strings.TrimSpace(family))
+	if family != "ipv4" && family != "ipv6" {
+		return BogonList{}, &InvalidArgumentError{Msg: "family must be ipv4 or ipv6"}
+	}
+	exportFormat, err := parseExportFormat(format, name)
+	if err != nil {
+		return BogonList{}, err
+	}
+
+	out := BogonList{
+		Family:   family,
+		Networks: geoip.Bogons(family == "ipv6"),
+	}
+	out.Format, out.Export, err = renderExport(exportFormat, name, out.Networks)
+	if err != nil {
+		return BogonList{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,61 @@
+package geocoder_api
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+// MaxRanges is the most ranges a GetRangeBreakdown request may break down.
+const MaxRanges = 100
+
+func (s *Service) GetRangeBreakdown(_ context.Context, ranges []string) ([]RangeBreakdown, error) {
+	d := s.current()
+	if d.store == nil {
+		return nil, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+	if len(ranges) == 0 {
+		return nil, &InvalidArgumentError{Msg: "ranges must not be empty"}
+	}
+	if len(ranges) > MaxRanges {
+		return nil, &InvalidArgumentError{Msg: fmt.Sprintf("at most %d ranges per request", MaxRanges)}
+	}
+
+	out := make([]RangeBreakdown, 0, len(ranges))
+	for _, raw := range ranges {
+		r, err := geoip.ParseRange(raw)
+		if err != nil {
+			return nil, &InvalidArgumentError{Msg: "invalid range " + raw + ": " + err.Error()}
+		}
+
+		b := d.store.Breakdown(r)
+		total := r.Size()
+		item := RangeBreakdown{
+			Query:                raw,
+			Range:                r,
+			Addresses:            total,
+			Countries:            make([]CountryShare, 0, len(b.Countries)),
+			Unallocated:          b.Unallocated,
+			UnallocatedAddresses: b.UnallocatedAddresses,
+			UnallocatedPercent:   percent(b.UnallocatedAddresses, total),
+		}
+		for _, c := range b.Countries {
+			item.Countries = append(item.Countries, CountryShare{
+				Code:      c.ISO,
+				Networks:  c.Networks,
+				Addresses: c.Addresses,
+				Percent:   percent(c.Addresses, total),
+			})
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+func percent(part, total *big.Int) float64 {
+	q := new(big.Float).Quo(new(big.Float).SetInt(part), new(big.Float).SetInt(total))
+	f, _ := q.Mul(q, big.NewFloat(100)).Float64()
+	return f
+}
@@ -0,0 +1,91 @@
+package geocoder_api
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+// MaxCidrs is the most CIDRs a GetCidrData request may look up.
+const MaxCidrs = 100
+
+func (s *Service) GetCidrData(_ context.Context, cidrs []string, page, size int) ([]CidrData, error) {
+	d := s.current()
+	if d.store == nil {
+		return nil, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+	if len(cidrs) == 0 {
+		return nil, &InvalidArgumentError{Msg: "cidrs must not be empty"}
+	}
+	if len(cidrs) > MaxCidrs {
+		return nil, &InvalidArgumentError{Msg: fmt.Sprintf("at most %d cidrs per request", MaxCidrs)}
+	}
+	if page < 0 {
+		return nil, &InvalidArgumentError{Msg: "page must be >= 0"}
+	}
+	if size <= 0 || size > MaxPageSize {
+		return nil, &InvalidArgumentError{Msg: fmt.Sprintf("size must be between 1 and %d", MaxPageSize)}
+	}
+
+	out := make([]CidrData, 0, len(cidrs))
+	for _, raw := range cidrs {
+		pfx, err := parseCIDR(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		item := CidrData{CIDR: pfx, Page: page, Size: size}
+		if n, ok := d.store.NetworkOf(pfx); ok {
+			nd := toNetworkData(n)
+			item.Exact = &nd
+		}
+		for _, n := range d.store.Supernets(pfx) {
+			item.Supernets = append(item.Supernets, toNetworkData(n))
+		}
+
+		subnets := d.store.Subnets(pfx)
+		total := subnets.Len()
+		from := min(page*size, total)
+		to := min(from+size, total)
+		subnets = subnets.Slice(from, to)
+
+		item.Subnets = make([]NetworkData, subnets.Len())
+		for i := range item.Subnets {
+			item.Subnets[i] = toNetworkData(subnets.At(i))
+		}
+		item.TotalSubnets = total
+		item.TotalPages = (total + size - 1) / size
+
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// parseCIDR accepts a CIDR with host bits set and clears them. IPv4-mapped
+// IPv6 prefixes are turned into IPv4 ones, as the store keeps them.
+func parseCIDR(raw string) (netip.Prefix, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return netip.Prefix{}, &InvalidArgumentError{Msg: "empty cidr"}
+	}
+	pfx, err := netip.ParsePrefix(raw)
+	if err != nil {
+		return netip.Prefix{}, &InvalidArgumentError{Msg: "invalid cidr: " + raw}
+	}
+	if a := pfx.Addr(); a.Is4In6() && pfx.Bits() >= 96 {
+		pfx = netip.PrefixFrom(a.Unmap(), pfx.Bits()-96)
+	}
+	return pfx.Masked(), nil
+}
+
+func toNetworkData(n geoip.Network) NetworkData {
+	return NetworkData{
+		Network:    n.Prefix,
+		Code:       n.ISO,
+		Source:     n.Source.String(),
+		Provenance: n.Provenance,
+	}
+}
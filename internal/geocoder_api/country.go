@@ -0,0 +1,62 @@
+package geocoder_api
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/countries"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) GetCountry(_ context.Context, code string) (CountryInfo, error) {
+	d := s.current()
+	if d.store == nil {
+		return CountryInfo{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return CountryInfo{}, &InvalidArgumentError{Msg: "code must not be empty"}
+	}
+
+	iso := countries.Alpha2(code)
+	c, known := countries.ByCode(iso)
+	sp, found := d.store.CountrySpace(iso)
+	if !known && !found {
+		return CountryInfo{}, &NotFoundError{Msg: "unknown iso code: " + strings.ToUpper(code)}
+	}
+
+	out := CountryInfo{
+		Code:      iso,
+		Alpha3:    c.Alpha3,
+		Numeric:   c.Numeric,
+		Name:      c.Name,
+		Continent: c.Continent,
+		Region:    c.Region,
+	}
+	if out.Name == "" {
+		out.Name = d.store.CountryName(iso)
+	}
+
+	if found {
+		out.IPv4 = toAddressSpace(sp.IPv4, false)
+		out.IPv6 = toAddressSpace(sp.IPv6, true)
+	} else {
+		out.IPv4 = AddressSpace{Addresses: new(big.Int)}
+		out.IPv6 = AddressSpace{Addresses: new(big.Int)}
+	}
+	return out, nil
+}
+
+func toAddressSpace(fs geoip.FamilySpace, ipv6 bool) AddressSpace {
+	out := AddressSpace{
+		Networks:  fs.Networks,
+		Addresses: fs.Addresses,
+		Percent:   fs.Share * 100,
+		Prefixes:  fs.Prefixes,
+	}
+	if ipv6 {
+		out.Slash64s = new(big.Int).Rsh(fs.Addresses, 64).Uint64()
+	}
+	return out
+}
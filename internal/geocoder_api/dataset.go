@@ -0,0 +1,36 @@
+package geocoder_api
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) GetDataset(_ context.Context) (DatasetMetadata, error) {
+	d := s.current()
+	if d.store == nil {
+		return DatasetMetadata{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+
+	sources := d.store.Sources()
+	out := DatasetMetadata{
+		Dataset: toDatasetInfo(d.store.Dataset()),
+		Sources: make([]SourceInfo, 0, len(sources)),
+	}
+	for _, src := range sources {
+		out.Sources = append(out.Sources, SourceInfo{
+			Name:     src.Name,
+			Dataset:  toDatasetInfo(src.Dataset),
+			Networks: src.Networks,
+		})
+	}
+	return out, nil
+}
+
+func toDatasetInfo(ds geoip.DatasetInfo) DatasetInfo {
+	return DatasetInfo{
+		Type:        ds.Type,
+		BuildTime:   ds.BuildTime,
+		Description: ds.Description,
+	}
+}
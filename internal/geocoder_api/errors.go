@@ -15,3 +15,11 @@ type NotFoundError struct {
 func (e *NotFoundError) Error() string {
 	return e.Msg
 }
+
+type ForbiddenError struct {
+	Msg string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Msg
+}
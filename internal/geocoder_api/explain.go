@@ -0,0 +1,76 @@
+package geocoder_api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) ExplainIp(_ context.Context, ip string) (IPExplanation, error) {
+	d := s.current()
+	if !s.opt.AdminEnabled {
+		return IPExplanation{}, &ForbiddenError{Msg: "admin endpoints are disabled"}
+	}
+	if d.store == nil {
+		return IPExplanation{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+
+	ip = strings.TrimSpace(ip)
+	if ip == "" {
+		return IPExplanation{}, &InvalidArgumentError{Msg: "empty ip"}
+	}
+	addr, ok := s.opt.Input.parseIP(ip)
+	if !ok {
+		return IPExplanation{}, &InvalidArgumentError{Msg: "invalid ip: " + ip}
+	}
+
+	t, err := d.resolver.Explain(addr)
+	if err != nil {
+		return IPExplanation{}, err
+	}
+
+	steps := make([]NormalizeStep, 0, len(t.Steps))
+	for _, st := range t.Steps {
+		steps = append(steps, NormalizeStep{
+			Field:      st.Field,
+			Raw:        st.Raw,
+			Normalized: st.Normalized,
+			Selected:   st.Selected,
+		})
+	}
+
+	return IPExplanation{
+		IP:            ip,
+		Address:       t.Address,
+		Alias:         t.Alias.String(),
+		EmbeddedIPv4:  t.EmbeddedIPv4,
+		AliasApplied:  t.AliasApplied,
+		LookupAddress: t.LookupAddress,
+		Found:         t.Found,
+		Network:       t.Network,
+		Record:        t.RawRecord,
+
+		DatabaseNetwork: t.DatabaseNetwork,
+		DatabaseCode:    t.DatabaseISO,
+		Override:        toOverrideInfo(t.Override),
+
+		Steps:  steps,
+		Code:   t.ISO,
+		Source: t.Source.String(),
+		Dataset: toDatasetInfo(t.Dataset),
+	}, nil
+}
+
+func toOverrideInfo(o *geoip.Override) *OverrideInfo {
+	if o == nil {
+		return nil
+	}
+	return &OverrideInfo{
+		Network: o.Prefix,
+		Code:    o.ISO,
+		Comment: o.Comment,
+		Expires: o.Expires,
+		Origin:  o.Origin,
+	}
+}
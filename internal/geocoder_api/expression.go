@@ -0,0 +1,97 @@
+package geocoder_api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/netip"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/export"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) EvaluateExpression(_ context.Context, expression, format, name string) (ExpressionResult, error) {
+	d := s.current()
+	if d.store == nil {
+		return ExpressionResult{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+	if strings.TrimSpace(expression) == "" {
+		return ExpressionResult{}, &InvalidArgumentError{Msg: "expression must not be empty"}
+	}
+
+	exportFormat, err := parseExportFormat(format, name)
+	if err != nil {
+		return ExpressionResult{}, err
+	}
+
+	expr, err := geoip.ParseExpr(expression)
+	if err != nil {
+		return ExpressionResult{}, expressionError(err)
+	}
+	set, err := expr.Eval(func(ident string) (geoip.NetSet, bool) {
+		return s.resolveSet(d.store, ident)
+	})
+	if err != nil {
+		return ExpressionResult{}, expressionError(err)
+	}
+
+	out := ExpressionResult{
+		Expression: expr.String(),
+		Networks:   set.Prefixes(),
+	}
+	out.Format, out.Export, err = renderExport(exportFormat, name, out.Networks)
+	if err != nil {
+		return ExpressionResult{}, err
+	}
+	return out, nil
+}
+
+// parseExportFormat validates an export format and set name; "" and "json"
+// give the zero Format.
+func parseExportFormat(format, name string) (export.Format, error) {
+	if format == "" || strings.EqualFold(format, "json") {
+		return "", nil
+	}
+	f, err := export.ParseFormat(format)
+	if err != nil {
+		return "", &InvalidArgumentError{Msg: err.Error()}
+	}
+	if name != "" {
+		if err := export.CheckName(name); err != nil {
+			return "", &InvalidArgumentError{Msg: err.Error()}
+		}
+	}
+	return f, nil
+}
+
+// renderExport renders prefixes in f; the zero Format gives "json" and no
+// export.
+func renderExport(f export.Format, name string, prefixes []netip.Prefix) (string, []byte, error) {
+	if f == "" {
+		return "json", nil, nil
+	}
+	var buf bytes.Buffer
+	if err := export.Write(&buf, f, name, prefixes); err != nil {
+		return "", nil, err
+	}
+	return string(f), buf.Bytes(), nil
+}
+
+// resolveSet resolves an expression identifier: a group, or an ISO code
+// present in the dataset.
+func (s *Service) resolveSet(store *geoip.Store, ident string) (geoip.NetSet, bool) {
+	ranges, ok := s.countryRanges(store, ident, geoip.BasisLocated)
+	if !ok {
+		return geoip.NetSet{}, false
+	}
+	return geoip.NewNetSet(ranges.Prefixes()...), true
+}
+
+func expressionError(err error) error {
+	var ee *geoip.ExprError
+	if errors.As(err, &ee) {
+		return &InvalidArgumentError{Msg: "invalid expression: " + ee.Error()}
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package geocoder_api
+
+import "context"
+
+func (s *Service) GetGroups(_ context.Context) ([]CountryGroup, error) {
+	groups := s.groups.All()
+	out := make([]CountryGroup, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, CountryGroup{
+			ID:      g.ID,
+			Name:    g.Name,
+			Kind:    g.Kind,
+			Members: g.Members,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package geocoder_api
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// InputOptions lists the spellings accepted for an IP address besides the
+// plain form. The zero value accepts plain addresses only.
+type InputOptions struct {
+	Ports    bool // "1.2.3.4:5678", "[2001:db8::1]:443"
+	Brackets bool // "[2001:db8::1]"
+	Zones    bool // "fe80::1%eth0"; the zone is dropped
+	Integers bool // "16909060", a decimal IPv4 address
+	CIDRs    bool // "1.2.3.0/24", looked up by its network address
+}
+
+// parseIP normalizes one input address. Zones are dropped from every
+// accepted form, so the result is always zone-free.
+func (o InputOptions) parseIP(s string) (netip.Addr, bool) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return o.unzone(addr)
+	}
+	if o.Ports {
+		if ap, err := netip.ParseAddrPort(s); err == nil {
+			return o.unzone(ap.Addr())
+		}
+	}
+	if o.Brackets && len(s) > 2 && s[0] == '[' && s[len(s)-1] == ']' {
+		if addr, err := netip.ParseAddr(s[1 : len(s)-1]); err == nil && addr.Is6() {
+			return o.unzone(addr)
+		}
+	}
+	if o.Integers {
+		if n, err := strconv.ParseUint(s, 10, 32); err == nil {
+			return netip.AddrFrom4([4]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)}), true
+		}
+	}
+	if o.CIDRs && strings.Contains(s, "/") {
+		if p, err := netip.ParsePrefix(s); err == nil {
+			return p.Masked().Addr(), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+func (o InputOptions) unzone(addr netip.Addr) (netip.Addr, bool) {
+	if addr.Zone() == "" {
+		return addr, true
+	}
+	return addr.WithZone(""), o.Zones
+}
@@ -0,0 +1,60 @@
+package geocoder_api
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+)
+
+func (s *Service) GetQualityReport(_ context.Context, limit int, threshold float64, minNetworks int) (QualityReport, error) {
+	d := s.current()
+	if d.store == nil {
+		return QualityReport{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
+	}
+	if limit < 0 || threshold < 0 || minNetworks < 0 {
+		return QualityReport{}, &InvalidArgumentError{Msg: "limit, threshold and minNetworks must not be negative"}
+	}
+
+	rep := d.store.QualityReport(geoip.QualityOptions{
+		Limit:           limit,
+		ChangeThreshold: threshold,
+		MinNetworks:     minNetworks,
+	})
+	out := QualityReport{
+		RegisteredDiffers:      toNetworkIssues(rep.RegisteredDiffers),
+		TotalRegisteredDiffers: rep.TotalRegisteredDiffers,
+		Fallbacks:              toNetworkIssues(rep.Fallbacks),
+		TotalFallbacks:         rep.TotalFallbacks,
+		AliasConflicts:         make([]AliasConflict, 0, len(rep.AliasConflicts)),
+		TotalAliasConflicts:    rep.TotalAliasConflicts,
+		HasPrevious:            rep.HasPrevious,
+		Changes:                make([]CountryChange, 0, len(rep.Changes)),
+		TotalChanges:           rep.TotalChanges,
+	}
+	for _, c := range rep.AliasConflicts {
+		out.AliasConflicts = append(out.AliasConflicts, AliasConflict{
+			Network:  c.Prefix,
+			Code:     c.ISO,
+			Alias:    c.Alias.String(),
+			IPv4:     c.IPv4,
+			IPv4Code: c.IPv4ISO,
+		})
+	}
+	for _, c := range rep.Changes {
+		out.Changes = append(out.Changes, CountryChange{Code: c.ISO, Before: c.Before, After: c.After, Percent: c.Change})
+	}
+	return out, nil
+}
+
+func toNetworkIssues(in []geoip.NetworkIssue) []NetworkIssue {
+	out := make([]NetworkIssue, 0, len(in))
+	for _, it := range in {
+		out = append(out, NetworkIssue{
+			Network:        it.Prefix,
+			Code:           it.ISO,
+			RegisteredCode: it.Other,
+			Provenance:     it.Layer,
+		})
+	}
+	return out
+}
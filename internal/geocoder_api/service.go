@@ -2,28 +2,77 @@ package geocoder_api
 
 import (
 	"context"
-	"net/netip"
+	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/Elessarov1/geocoder-go/internal/common/version"
+	"github.com/Elessarov1/geocoder-go/internal/countries"
 	"github.com/Elessarov1/geocoder-go/internal/geoip"
 
 	"github.com/oschwald/maxminddb-golang"
 )
 
+type Options struct {
+	// AdminEnabled exposes diagnostic endpoints (ExplainIp).
+	AdminEnabled bool
+	// Groups are the country groups accepted in place of ISO codes; nil
+	// means the built-in ones.
+	Groups *countries.Groups
+	// UnwrapAliases lists the tunnel kinds (geoip.Alias6to4,
+	// geoip.AliasTeredo) looked up by their embedded IPv4 address;
+	// IPv4-mapped addresses always are.
+	UnwrapAliases []geoip.AliasKind
+	// Input lists the address spellings GetIpData and ExplainIp accept
+	// besides plain addresses.
+	Input InputOptions
+}
+
 type Service struct {
-	store     *geoip.Store
+	data      atomic.Pointer[data]
 	mmdb      *maxminddb.Reader
+	groups    *countries.Groups
 	startTime time.Time
+	opt       Options
+}
+
+// data is the store the API answers from and its resolver. SetStore replaces
+// both at once, so a request sees a single store throughout.
+type data struct {
+	store    *geoip.Store
+	resolver *geoip.Resolver
 }
 
-func NewService(store *geoip.Store, mmdb *maxminddb.Reader, startTime time.Time) *Service {
-	return &Service{
-		store:     store,
+// NewService builds the API over store. mmdb is optional: without it lookups
+// are answered from the store (non-MMDB sources). In a layered store it
+// answers the addresses of the MMDB layer.
+func NewService(store *geoip.Store, mmdb *maxminddb.Reader, startTime time.Time, opt Options) *Service {
+	groups := opt.Groups
+	if groups == nil {
+		groups = countries.Builtin()
+	}
+	s := &Service{
 		mmdb:      mmdb,
+		groups:    groups,
 		startTime: startTime,
+		opt:       opt,
 	}
+	s.SetStore(store)
+	return s
+}
+
+// SetStore makes the API answer from store, say a rebuild of the current one;
+// requests already running finish on the store they started with.
+func (s *Service) SetStore(store *geoip.Store) {
+	s.data.Store(&data{
+		store:    store,
+		resolver: geoip.NewResolver(s.mmdb, store, s.opt.UnwrapAliases...),
+	})
+}
+
+func (s *Service) current() *data {
+	return s.data.Load()
 }
 
 var _ API = (*Service)(nil)
@@ -36,28 +85,42 @@ func (s *Service) Health(_ context.Context) (Health, error) {
 }
 
 func (s *Service) GetCountries(_ context.Context) ([]CountryRangeData, error) {
-	if s.store == nil {
+	d := s.current()
+	if d.store == nil {
 		return nil, &InvalidArgumentError{Msg: "geoip store is not loaded"}
 	}
 
-	codes := s.store.CountryCodes()
+	codes := d.store.CountryCodes()
 	out := make([]CountryRangeData, 0, len(codes))
 	for _, code := range codes {
-		out = append(out, CountryRangeData{
+		item := CountryRangeData{
 			Code:        code,
-			RangesCount: s.store.RangesCountByCountry(code),
-		})
+			RangesCount: d.store.RangesCountByCountry(code),
+		}
+		if sp, ok := d.store.CountrySpace(code); ok {
+			v4, v6 := toAddressSpace(sp.IPv4, false), toAddressSpace(sp.IPv6, true)
+			item.IPv4Addresses = v4.Addresses.Uint64()
+			item.IPv6Slash64s = v6.Slash64s
+			item.IPv4Percent = v4.Percent
+			item.IPv6Percent = v6.Percent
+		}
+		out = append(out, item)
 	}
 	return out, nil
 }
 
-func (s *Service) GetIpData(_ context.Context, ips []string) ([]GeoIPData, error) {
-	if s.mmdb == nil {
-		return nil, &InvalidArgumentError{Msg: "mmdb reader is not initialized"}
+func (s *Service) GetIpData(_ context.Context, ips []string, basis string) ([]GeoIPData, error) {
+	d := s.current()
+	if d.store == nil {
+		return nil, &InvalidArgumentError{Msg: "geoip store is not loaded"}
 	}
 	if len(ips) == 0 {
 		return nil, &InvalidArgumentError{Msg: "ips must not be empty"}
 	}
+	b, err := parseBasis(basis)
+	if err != nil {
+		return nil, err
+	}
 
 	out := make([]GeoIPData, 0, len(ips))
 
@@ -67,40 +130,78 @@ func (s *Service) GetIpData(_ context.Context, ips []string) ([]GeoIPData, error
 			return nil, &InvalidArgumentError{Msg: "empty ip"}
 		}
 
-		addr, err := netip.ParseAddr(ipStr)
-		if err != nil {
+		addr, ok := s.opt.Input.parseIP(ipStr)
+		if !ok {
 			return nil, &InvalidArgumentError{Msg: "invalid ip: " + ipStr}
 		}
 
-		var rec geoip.Record
-		_ = s.mmdb.Lookup(addr.AsSlice(), &rec)
+		m, err := d.resolver.Lookup(addr)
+		if err != nil {
+			return nil, err
+		}
 
-		iso := normalizeISO(rec.Country.ISOCode)
-		if iso == "" {
-			iso = normalizeISO(rec.RegisteredCountry.ISOCode)
+		item := GeoIPData{
+			IP:                ipStr,
+			NormalizedIP:      addr,
+			Code:              m.ISO,
+			Network:           m.Network,
+			Source:            m.Source.String(),
+			RegisteredCode:    m.Registered,
+			RegisteredDiffers: m.RegisteredDiffers(),
+			Provenance:        m.Provenance,
+			AddressClass:      geoip.ClassNone.String(),
 		}
-		if iso == "" {
-			iso = geoip.UnknownISO
+		// Overrides are local decisions and win on either basis.
+		if b == geoip.BasisRegistered && m.Override == nil && m.Registered != "" {
+			item.Code = m.Registered
+			item.Source = geoip.SourceRegisteredCountry.String()
 		}
-
-		out = append(out, GeoIPData{
-			IP:   ipStr,
-			Code: iso,
-		})
+		item.CountryName = d.store.CountryName(item.Code)
+		if m.Alias != geoip.AliasNone {
+			item.Alias, item.LookupIP = m.Alias.String(), m.Address
+		}
+		// The class of the address looked up: a 6to4 address of 10.0.0.1 is
+		// as private as 10.0.0.1.
+		if blk, bogon, ok := geoip.Classify(m.Address); ok {
+			item.AddressClass = blk.Class.String()
+			item.Special = &SpecialBlock{Network: blk.Prefix, Name: blk.Name, RFC: blk.RFC}
+			item.Bogon = bogon
+		}
+		out = append(out, item)
 	}
 
 	return out, nil
 }
 
-func (s *Service) GetCountryNetworks(_ context.Context, isoCodes []string) ([]IsoCodeNetworks, error) {
-	if s.store == nil {
+func (s *Service) GetCountryNetworks(_ context.Context, isoCodes []string, basis string, withProvenance bool) ([]IsoCodeNetworks, error) {
+	d := s.current()
+	if d.store == nil {
 		return nil, &InvalidArgumentError{Msg: "geoip store is not loaded"}
 	}
 	if len(isoCodes) == 0 {
 		return nil, &InvalidArgumentError{Msg: "isoCodes must not be empty"}
 	}
+	b, err := parseBasis(basis)
+	if err != nil {
+		return nil, err
+	}
 
 	out := make([]IsoCodeNetworks, 0, len(isoCodes))
+	seen := make(map[string]bool, len(isoCodes))
+	add := func(code string, ranges geoip.Ranges) {
+		if seen[code] {
+			return
+		}
+		seen[code] = true
+		item := IsoCodeNetworks{
+			Code:     code,
+			Networks: ranges, // read-only view, без копирования
+		}
+		if withProvenance {
+			item.Provenance = provenance(d.store, ranges)
+		}
+		out = append(out, item)
+	}
 
 	for _, code := range isoCodes {
 		code = strings.ToUpper(strings.TrimSpace(code))
@@ -108,22 +209,33 @@ func (s *Service) GetCountryNetworks(_ context.Context, isoCodes []string) ([]Is
 			return nil, &InvalidArgumentError{Msg: "isoCode must not be empty"}
 		}
 
-		ranges, ok := s.store.RangesByCountryUnsafe(code)
+		// A group lists its members; members without networks are skipped.
+		if grp, ok := s.groups.Lookup(code); ok {
+			for _, m := range grp.Members {
+				if ranges, ok := d.store.RangesByBasis(m, b); ok {
+					add(m, ranges)
+				}
+			}
+			continue
+		}
+
+		iso := countries.Alpha2(code)
+		ranges, ok := d.store.RangesByBasis(iso, b)
 		if !ok {
 			return nil, &NotFoundError{Msg: "unknown iso code: " + code}
 		}
-
-		out = append(out, IsoCodeNetworks{
-			Code:     code,
-			Networks: ranges, // read-only view, без копирования
-		})
+		add(iso, ranges)
 	}
 
 	return out, nil
 }
 
-func (s *Service) GetCountryNetworksPaged(_ context.Context, isoCode string, page, size int) (PageData, error) {
-	if s.store == nil {
+// MaxPageSize is the largest page of networks a request may ask for.
+const MaxPageSize = 100000
+
+func (s *Service) GetCountryNetworksPaged(_ context.Context, isoCode string, page, size int, basis string, withProvenance bool) (PageData, error) {
+	d := s.current()
+	if d.store == nil {
 		return PageData{}, &InvalidArgumentError{Msg: "geoip store is not loaded"}
 	}
 
@@ -134,16 +246,20 @@ func (s *Service) GetCountryNetworksPaged(_ context.Context, isoCode string, pag
 	if page < 0 {
 		return PageData{}, &InvalidArgumentError{Msg: "page must be >= 0"}
 	}
-	if size <= 0 {
-		return PageData{}, &InvalidArgumentError{Msg: "size must be >= 1"}
+	if size <= 0 || size > MaxPageSize {
+		return PageData{}, &InvalidArgumentError{Msg: fmt.Sprintf("size must be between 1 and %d", MaxPageSize)}
+	}
+	b, err := parseBasis(basis)
+	if err != nil {
+		return PageData{}, err
 	}
 
-	ranges, ok := s.store.RangesByCountryUnsafe(isoCode)
+	ranges, ok := s.countryRanges(d.store, isoCode, b)
 	if !ok {
 		return PageData{}, &NotFoundError{Msg: "unknown iso code: " + isoCode}
 	}
 
-	total := len(ranges)
+	total := ranges.Len()
 	from := page * size
 	if from > total {
 		from = total
@@ -155,19 +271,50 @@ func (s *Service) GetCountryNetworksPaged(_ context.Context, isoCode string, pag
 
 	totalPages := (total + size - 1) / size
 
-	return PageData{
-		Content:       ranges[from:to], // slice view
+	pd := PageData{
+		Content:       ranges.Slice(from, to), // slice view
 		TotalElements: total,
 		TotalPages:    totalPages,
 		Page:          page,
 		Size:          size,
-	}, nil
+	}
+	if withProvenance {
+		pd.Provenance = provenance(d.store, pd.Content)
+	}
+	return pd, nil
+}
+
+// countryRanges returns the networks of an alpha-2 or alpha-3 code, or of
+// the members of a group one country after another.
+func (s *Service) countryRanges(store *geoip.Store, code string, basis geoip.Basis) (geoip.Ranges, bool) {
+	grp, ok := s.groups.Lookup(code)
+	if !ok {
+		return store.RangesByBasis(countries.Alpha2(code), basis)
+	}
+	parts := make([]geoip.Ranges, 0, len(grp.Members))
+	for _, m := range grp.Members {
+		if r, ok := store.RangesByBasis(m, basis); ok {
+			parts = append(parts, r)
+		}
+	}
+	return geoip.JoinRanges(parts...), true
+}
+
+// parseBasis accepts "located" (or "") and "registered".
+func parseBasis(basis string) (geoip.Basis, error) {
+	switch strings.ToLower(strings.TrimSpace(basis)) {
+	case "", "located":
+		return geoip.BasisLocated, nil
+	case "registered":
+		return geoip.BasisRegistered, nil
+	}
+	return 0, &InvalidArgumentError{Msg: "basis must be located or registered"}
 }
 
-func normalizeISO(s string) string {
-	s = strings.TrimSpace(s)
-	if s == "" {
-		return ""
+func provenance(store *geoip.Store, networks geoip.Ranges) []string {
+	out := make([]string, networks.Len())
+	for i, p := range networks.All() {
+		out[i] = store.ProvenanceOf(p)
 	}
-	return strings.ToUpper(s)
+	return out
 }
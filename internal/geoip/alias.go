@@ -0,0 +1,77 @@
+package geoip
+
+import (
+	"net/netip"
+	"slices"
+)
+
+// AliasKind identifies IPv6 ranges that embed an IPv4 address.
+type AliasKind uint8
+
+const (
+	AliasNone AliasKind = iota
+	AliasIPv4Mapped
+	Alias6to4
+	AliasTeredo
+)
+
+func (k AliasKind) String() string {
+	switch k {
+	case AliasIPv4Mapped:
+		return "ipv4_mapped"
+	case Alias6to4:
+		return "6to4"
+	case AliasTeredo:
+		return "teredo"
+	default:
+		return "none"
+	}
+}
+
+var (
+	ipv4MappedPrefix = netip.MustParsePrefix("::ffff:0:0/96")
+	sixToFourPrefix  = netip.MustParsePrefix("2002::/16")
+	teredoPrefix     = netip.MustParsePrefix("2001::/32")
+)
+
+// EmbeddedIPv4 detects IPv4-mapped (::ffff:a.b.c.d), 6to4 (2002:AABB:CCDD::/48)
+// and Teredo (2001::/32, client address obfuscated in the last 32 bits)
+// addresses and returns the IPv4 address they carry.
+func EmbeddedIPv4(addr netip.Addr) (netip.Addr, AliasKind) {
+	if !addr.Is6() {
+		return netip.Addr{}, AliasNone
+	}
+	addr = addr.WithZone("")
+	b := addr.As16()
+
+	switch {
+	case ipv4MappedPrefix.Contains(addr):
+		return addr.Unmap(), AliasIPv4Mapped
+	case sixToFourPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]}), Alias6to4
+	case teredoPrefix.Contains(addr):
+		return netip.AddrFrom4([4]byte{^b[12], ^b[13], ^b[14], ^b[15]}), AliasTeredo
+	default:
+		return netip.Addr{}, AliasNone
+	}
+}
+
+// ParseAliasKind parses a name printed by AliasKind.String, "none" aside.
+func ParseAliasKind(name string) (AliasKind, bool) {
+	for _, k := range []AliasKind{AliasIPv4Mapped, Alias6to4, AliasTeredo} {
+		if k.String() == name {
+			return k, true
+		}
+	}
+	return AliasNone, false
+}
+
+// Unwrap returns the IPv4 address embedded in addr if addr is an alias of
+// one of kinds; otherwise addr itself and AliasNone.
+func Unwrap(addr netip.Addr, kinds ...AliasKind) (netip.Addr, AliasKind) {
+	v4, kind := EmbeddedIPv4(addr)
+	if kind == AliasNone || !slices.Contains(kinds, kind) {
+		return addr, AliasNone
+	}
+	return v4, kind
+}
@@ -0,0 +1,129 @@
+package geoip
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestEmbeddedIPv4(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+		kind AliasKind
+	}{
+		{"::ffff:1.2.3.4", "1.2.3.4", AliasIPv4Mapped},
+		{"2002:102:304::1", "1.2.3.4", Alias6to4},
+		{"2002:c000:204:1::1", "192.0.2.4", Alias6to4},
+		// Teredo: server 65.54.227.120, client 1.2.3.4 stored inverted.
+		{"2001:0:4136:e378:8000:63bf:fefd:fcfb", "1.2.3.4", AliasTeredo},
+		{"2001:db8::1", "", AliasNone},
+		{"2001:4860:4860::8888", "", AliasNone},
+		{"1.2.3.4", "", AliasNone},
+	}
+	for _, tt := range tests {
+		got, kind := EmbeddedIPv4(netip.MustParseAddr(tt.addr))
+		if kind != tt.kind {
+			t.Errorf("EmbeddedIPv4(%s) kind = %s, want %s", tt.addr, kind, tt.kind)
+			continue
+		}
+		if tt.want != "" && got != netip.MustParseAddr(tt.want) {
+			t.Errorf("EmbeddedIPv4(%s) = %s, want %s", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestUnwrap(t *testing.T) {
+	addr := netip.MustParseAddr("2002:102:304::1")
+
+	if got, kind := Unwrap(addr, Alias6to4); kind != Alias6to4 || got != netip.MustParseAddr("1.2.3.4") {
+		t.Errorf("Unwrap(6to4) = %s %s", got, kind)
+	}
+	if got, kind := Unwrap(addr, AliasTeredo); kind != AliasNone || got != addr {
+		t.Errorf("Unwrap without 6to4 = %s %s, want the address unchanged", got, kind)
+	}
+	if got, kind := Unwrap(netip.MustParseAddr("8.8.8.8"), Alias6to4, AliasTeredo); kind != AliasNone || got.String() != "8.8.8.8" {
+		t.Errorf("Unwrap(IPv4) = %s %s", got, kind)
+	}
+}
+
+func TestParseAliasKind(t *testing.T) {
+	for _, k := range []AliasKind{AliasIPv4Mapped, Alias6to4, AliasTeredo} {
+		if got, ok := ParseAliasKind(k.String()); !ok || got != k {
+			t.Errorf("ParseAliasKind(%q) = %s, %v", k.String(), got, ok)
+		}
+	}
+	if _, ok := ParseAliasKind("none"); ok {
+		t.Error(`ParseAliasKind("none") succeeded`)
+	}
+}
+
+func TestResolverUnwrapsAliases(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"1.2.3.0/24", "DE", SourceCountry},
+		{"5.6.7.0/24", "NL", SourceCountry},
+		// Present when the database is loaded without SkipAliasedNetworks.
+		{"2001::/32", "US", SourceCountry},
+	}, []Override{{Prefix: netip.MustParsePrefix("5.6.7.8/32"), ISO: "FR"}})
+
+	const teredo = "2001:0:4136:e378:8000:63bf:fefd:fcfb" // 1.2.3.4
+
+	tests := []struct {
+		name    string
+		unwrap  []AliasKind
+		addr    string
+		iso     string
+		alias   AliasKind
+		address string
+	}{
+		{"mapped always", nil, "::ffff:1.2.3.4", "DE", AliasIPv4Mapped, "1.2.3.4"},
+		{"6to4", []AliasKind{Alias6to4}, "2002:102:304::1", "DE", Alias6to4, "1.2.3.4"},
+		{"6to4 off", nil, "2002:102:304::1", "ZZ", AliasNone, "2002:102:304::1"},
+		{"teredo", []AliasKind{AliasTeredo}, teredo, "DE", AliasTeredo, "1.2.3.4"},
+		{"teredo off", []AliasKind{Alias6to4}, teredo, "US", AliasNone, teredo},
+		{"override on embedded", []AliasKind{Alias6to4}, "2002:506:708::1", "FR", Alias6to4, "5.6.7.8"},
+		{"plain IPv6", []AliasKind{Alias6to4, AliasTeredo}, "2001:db8::1", "ZZ", AliasNone, "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewResolver(nil, s, tt.unwrap...)
+			m, err := r.Lookup(netip.MustParseAddr(tt.addr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.ISO != tt.iso || m.Alias != tt.alias || m.Address.String() != tt.address {
+				t.Errorf("Lookup(%s) = %s alias %s address %s, want %s alias %s address %s",
+					tt.addr, m.ISO, m.Alias, m.Address, tt.iso, tt.alias, tt.address)
+			}
+
+			tr, err := r.Explain(netip.MustParseAddr(tt.addr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tr.ISO != m.ISO || tr.AliasApplied != (tt.alias != AliasNone) || tr.LookupAddress != m.Address {
+				t.Errorf("Explain(%s) = %s applied %v lookup %s, disagrees with Lookup",
+					tt.addr, tr.ISO, tr.AliasApplied, tr.LookupAddress)
+			}
+		})
+	}
+}
+
+func TestUnwrappedAliasClass(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{{"10.0.0.0/8", "DE", SourceCountry}}, nil)
+	r := NewResolver(nil, s, Alias6to4)
+
+	// 6to4 of 10.0.0.1: looked up, and so classified, as 10.0.0.1.
+	addr := netip.MustParseAddr("2002:0a00:0001::")
+	m, err := r.Lookup(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Alias != Alias6to4 || m.Address != netip.MustParseAddr("10.0.0.1") || m.ISO != "DE" {
+		t.Fatalf("Lookup(%s) = %s alias %s address %s", addr, m.ISO, m.Alias, m.Address)
+	}
+	if blk, bogon, ok := Classify(m.Address); !ok || blk.Class != ClassPrivate || !bogon {
+		t.Errorf("Classify(%s) = %s bogon %v, want private bogon", m.Address, blk.Class, bogon)
+	}
+	if blk, _, _ := Classify(addr); blk.Class == ClassPrivate {
+		t.Errorf("Classify(%s) = private; the 6to4 block itself is not", addr)
+	}
+}
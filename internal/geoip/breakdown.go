@@ -0,0 +1,237 @@
+package geoip
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// AddrRange is an inclusive address range of one family.
+type AddrRange struct {
+	First, Last netip.Addr
+}
+
+func (r AddrRange) String() string {
+	return r.First.String() + "-" + r.Last.String()
+}
+
+// Size returns the number of addresses in r.
+func (r AddrRange) Size() *big.Int {
+	n := new(big.Int).Sub(addrInt(r.Last), addrInt(r.First))
+	return n.Add(n, big.NewInt(1))
+}
+
+// Prefixes returns the minimal list of prefixes covering r.
+func (r AddrRange) Prefixes() []netip.Prefix {
+	return rangeToPrefixes(r.First, r.Last)
+}
+
+func addrInt(a netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(a.AsSlice())
+}
+
+// ParseRange parses a CIDR ("185.0.0.0/14"), a range
+// ("185.0.0.0-185.3.255.255") or a single address. Host bits of a CIDR are
+// ignored and IPv4-mapped IPv6 input is treated as IPv4.
+func ParseRange(s string) (AddrRange, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return AddrRange{}, errors.New("empty range")
+	}
+
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return AddrRange{}, err
+		}
+		if a := p.Addr(); a.Is4In6() && p.Bits() >= 96 {
+			p = netip.PrefixFrom(a.Unmap(), p.Bits()-96)
+		}
+		p = p.Masked()
+		return AddrRange{First: p.Addr(), Last: lastAddr(p)}, nil
+	}
+
+	from, to, isRange := strings.Cut(s, "-")
+	first, err := netip.ParseAddr(strings.TrimSpace(from))
+	if err != nil {
+		return AddrRange{}, err
+	}
+	last := first
+	if isRange {
+		if last, err = netip.ParseAddr(strings.TrimSpace(to)); err != nil {
+			return AddrRange{}, err
+		}
+	}
+	first, last = first.WithZone("").Unmap(), last.WithZone("").Unmap()
+	if first.Is4() != last.Is4() {
+		return AddrRange{}, fmt.Errorf("range %q mixes IPv4 and IPv6", s)
+	}
+	if last.Less(first) {
+		return AddrRange{}, fmt.Errorf("range %q ends before it starts", s)
+	}
+	return AddrRange{First: first, Last: last}, nil
+}
+
+// CountryShare is the part of a range one country answers for.
+type CountryShare struct {
+	ISO string
+	// Networks answering for some of the range, in address order. They may
+	// extend beyond it.
+	Networks  []netip.Prefix
+	Addresses *big.Int // addresses of the range only
+}
+
+// Breakdown splits a range by the country each address resolves to, the
+// most specific stored network winning.
+type Breakdown struct {
+	Range     AddrRange
+	Countries []CountryShare // most addresses first
+
+	// Unallocated lists the parts no stored network covers, in address order.
+	Unallocated          []AddrRange
+	UnallocatedAddresses *big.Int
+}
+
+// Breakdown resolves every address of r against the stored networks
+// (overrides included, since they are stored as networks).
+func (s *Store) Breakdown(r AddrRange) Breakdown {
+	// Every stored network overlapping r contains, equals or lies inside one
+	// of the prefixes r is made of.
+	seen := make(map[netip.Prefix]bool)
+	var nets []Network
+	for _, p := range r.Prefixes() {
+		outer := s.Supernets(p)
+		if n, ok := s.NetworkOf(p); ok {
+			outer = append(outer, n)
+		}
+		for _, n := range outer {
+			if !seen[n.Prefix] {
+				seen[n.Prefix] = true
+				nets = append(nets, n)
+			}
+		}
+		sub := s.Subnets(p)
+		for i := 0; i < sub.Len(); i++ {
+			nets = append(nets, sub.At(i))
+		}
+	}
+
+	// Address order, enclosing networks before the ones inside them.
+	slices.SortFunc(nets, func(a, b Network) int {
+		if prefixLess(a.Prefix, b.Prefix) {
+			return -1
+		}
+		if prefixLess(b.Prefix, a.Prefix) {
+			return 1
+		}
+		return 0
+	})
+
+	type piece struct {
+		AddrRange
+		net int // index into nets
+	}
+	pieces := make([]piece, 0, len(nets))
+	emit := func(first, last netip.Addr, i int) {
+		if !first.IsValid() {
+			return // the sweep went past the end of the address space
+		}
+		if first.Less(r.First) {
+			first = r.First
+		}
+		if r.Last.Less(last) {
+			last = r.Last
+		}
+		if last.Less(first) {
+			return // outside r
+		}
+		pieces = append(pieces, piece{AddrRange{first, last}, i})
+	}
+
+	// One sweep, as in computeSpace: the networks enclosing the current one
+	// are on the stack, and the addresses between two networks belong to the
+	// innermost network around them. next is the first address not handed
+	// out yet; it turns invalid past the end of the address space.
+	var (
+		stack []int
+		next  netip.Addr
+	)
+	pop := func() {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		last := lastAddr(nets[i].Prefix)
+		emit(next, last, i)
+		next = last.Next()
+	}
+	for i, n := range nets {
+		for len(stack) > 0 && !nets[stack[len(stack)-1]].Prefix.Contains(n.Prefix.Addr()) {
+			pop()
+		}
+		if len(stack) > 0 && next.Less(n.Prefix.Addr()) {
+			emit(next, n.Prefix.Addr().Prev(), stack[len(stack)-1])
+		}
+		next = n.Prefix.Addr()
+		stack = append(stack, i)
+	}
+	for len(stack) > 0 {
+		pop()
+	}
+
+	out := Breakdown{Range: r, UnallocatedAddresses: new(big.Int)}
+	gap := func(first, last netip.Addr) {
+		g := AddrRange{first, last}
+		out.Unallocated = append(out.Unallocated, g)
+		out.UnallocatedAddresses.Add(out.UnallocatedAddresses, g.Size())
+	}
+
+	byISO := make(map[string]int)
+	listed := make(map[int]bool) // networks already in their country's list
+	cursor, done := r.First, false
+	for _, pc := range pieces {
+		if cursor.Less(pc.First) {
+			gap(cursor, pc.First.Prev())
+		}
+		if pc.Last == r.Last {
+			done = true
+		} else {
+			cursor = pc.Last.Next()
+		}
+
+		n := nets[pc.net]
+		ci, ok := byISO[n.ISO]
+		if !ok {
+			ci = len(out.Countries)
+			byISO[n.ISO] = ci
+			out.Countries = append(out.Countries, CountryShare{ISO: n.ISO, Addresses: new(big.Int)})
+		}
+		cs := &out.Countries[ci]
+		cs.Addresses.Add(cs.Addresses, pc.Size())
+		if !listed[pc.net] {
+			listed[pc.net] = true
+			cs.Networks = append(cs.Networks, n.Prefix)
+		}
+	}
+	if !done {
+		gap(cursor, r.Last)
+	}
+
+	for _, cs := range out.Countries {
+		slices.SortFunc(cs.Networks, func(a, b netip.Prefix) int {
+			if c := a.Addr().Compare(b.Addr()); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.Bits(), b.Bits())
+		})
+	}
+	slices.SortStableFunc(out.Countries, func(a, b CountryShare) int {
+		if c := b.Addresses.Cmp(a.Addresses); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ISO, b.ISO)
+	})
+	return out
+}
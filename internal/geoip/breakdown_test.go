@@ -0,0 +1,134 @@
+package geoip
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// describe renders a breakdown as "ISO=addresses[networks]" entries and
+// the gaps, for comparison.
+func describe(b Breakdown) string {
+	var parts []string
+	for _, c := range b.Countries {
+		parts = append(parts, fmt.Sprintf("%s=%s%v", c.ISO, c.Addresses, c.Networks))
+	}
+	for _, g := range b.Unallocated {
+		parts = append(parts, "gap "+g.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestBreakdown(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"10.0.0.0/8", "DE", SourceCountry},
+		{"10.1.0.0/16", "FR", SourceCountry},
+		{"10.1.2.0/24", "NL", SourceCountry},
+		{"10.3.0.0/16", "IT", SourceCountry},
+		{"11.0.0.0/16", "US", SourceCountry},
+		{"12.0.0.0/24", "BR", SourceCountry},
+		{"::/0", "US", SourceCountry},
+		{"2001:db8::/32", "DE", SourceCountry},
+	}, nil)
+
+	// Networks ending at the last address of their family.
+	end := buildTestStore(t, []testNetwork{
+		{"240.0.0.0/4", "DE", SourceCountry},
+		{"255.255.255.0/24", "FR", SourceCountry},
+		{"ffff::/16", "DE", SourceCountry},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120", "FR", SourceCountry},
+	}, nil)
+
+	tests := []struct {
+		store *Store
+		rng   string
+		want  string
+		gaps  string // unallocated addresses
+	}{
+		{
+			store: s,
+			rng:   "10.0.255.0-10.1.2.255",
+			want:  "FR=512[10.1.0.0/16] DE=256[10.0.0.0/8] NL=256[10.1.2.0/24]",
+			gaps:  "0",
+		},
+		{
+			store: s,
+			rng:   "10.1.2.128-11.0.0.127",
+			want: "DE=16580608[10.0.0.0/8] IT=65536[10.3.0.0/16] FR=64768[10.1.0.0/16] " +
+				"NL=128[10.1.2.0/24] US=128[11.0.0.0/16]",
+			gaps: "0",
+		},
+		{
+			store: s,
+			rng:   "10.255.255.255-12.0.0.0",
+			want:  "US=65536[11.0.0.0/16] BR=1[12.0.0.0/24] DE=1[10.0.0.0/8] gap 11.1.0.0-11.255.255.255",
+			gaps:  "16711680",
+		},
+		{
+			store: s,
+			rng:   "10.1.2.3",
+			want:  "NL=1[10.1.2.0/24]",
+			gaps:  "0",
+		},
+		{
+			store: s,
+			rng:   "9.0.0.0/8",
+			want:  "gap 9.0.0.0-9.255.255.255",
+			gaps:  "16777216",
+		},
+		{
+			store: s,
+			rng:   "::/0",
+			want: "US=340282366841710300949110269838224261120[::/0] " +
+				"DE=79228162514264337593543950336[2001:db8::/32]",
+			gaps: "0",
+		},
+		{
+			store: end,
+			rng:   "255.255.255.0/24",
+			want:  "FR=256[255.255.255.0/24]",
+			gaps:  "0",
+		},
+		{
+			store: end,
+			rng:   "255.255.0.0-255.255.255.255",
+			want:  "DE=65280[240.0.0.0/4] FR=256[255.255.255.0/24]",
+			gaps:  "0",
+		},
+		{
+			store: end,
+			rng:   "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120",
+			want:  "FR=256[ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120]",
+			gaps:  "0",
+		},
+		{
+			store: end,
+			rng:   "ffff:ffff:ffff:ffff:ffff:ffff:ffff:0/112",
+			want:  "DE=65280[ffff::/16] FR=256[ffff:ffff:ffff:ffff:ffff:ffff:ffff:ff00/120]",
+			gaps:  "0",
+		},
+	}
+	for _, tt := range tests {
+		r, err := ParseRange(tt.rng)
+		if err != nil {
+			t.Fatalf("ParseRange(%q): %v", tt.rng, err)
+		}
+		b := tt.store.Breakdown(r)
+		if got := describe(b); got != tt.want {
+			t.Errorf("Breakdown(%s)\n got %s\nwant %s", tt.rng, got, tt.want)
+		}
+		if b.UnallocatedAddresses.String() != tt.gaps {
+			t.Errorf("Breakdown(%s) unallocated = %s, want %s", tt.rng, b.UnallocatedAddresses, tt.gaps)
+		}
+
+		// The shares and the gaps add up to the range.
+		sum := new(big.Int).Set(b.UnallocatedAddresses)
+		for _, c := range b.Countries {
+			sum.Add(sum, c.Addresses)
+		}
+		if sum.Cmp(r.Size()) != 0 {
+			t.Errorf("Breakdown(%s) accounts for %s addresses, want %s", tt.rng, sum, r.Size())
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+)
+
+// builder accumulates networks into a new Store. Space claimed by overrides
+// is carved out of every added network; the override networks themselves are
+// added by finish.
+type builder struct {
+	s     *Store
+	layer uint8 // layer new networks are attributed to
+
+	// shadowed collects the networks overrides took space from; carved
+	// maps the pieces left of them to where they were added.
+	shadowed []shadowNet
+	carved   map[netip.Prefix]carvedPiece
+
+	skipUnknown, skipV4, skipV6 bool
+}
+
+// newBuilder starts a store; a non-empty source names its first layer.
+func newBuilder(opt Options, source string, sizeV4, sizeV6 int) *builder {
+	if opt.UnknownISO == "" {
+		opt.UnknownISO = UnknownISO
+	}
+	b := &builder{
+		s: &Store{
+			isoByID:  make([]string, 0, 256),
+			idByISO:  make(map[string]CountryID, 256),
+			nameByID: make([]string, 0, 256),
+
+			v4: make([]v4Net, 0, sizeV4),
+			v6: make([]v6Net, 0, sizeV6),
+
+			overrides:  newOverrideSet(opt.Overrides, time.Now()),
+			unknownISO: opt.UnknownISO,
+			preference: opt.Preference,
+		},
+		skipUnknown: opt.SkipUnknown,
+		skipV4:      opt.SkipIPv4,
+		skipV6:      opt.SkipIPv6,
+	}
+	if source != "" {
+		b.beginLayer(source, DatasetInfo{})
+	}
+	return b
+}
+
+// beginLayer attributes networks added from now on to a new source layer.
+func (b *builder) beginLayer(name string, ds DatasetInfo) {
+	b.s.layers = append(b.s.layers, SourceInfo{Name: name, Dataset: ds})
+	b.layer = uint8(len(b.s.layers) - 1)
+}
+
+func (b *builder) countryID(iso string) CountryID {
+	s := b.s
+	if id, ok := s.idByISO[iso]; ok {
+		return id
+	}
+	id := CountryID(len(s.isoByID))
+	s.idByISO[iso] = id
+	s.isoByID = append(s.isoByID, iso)
+	s.nameByID = append(s.nameByID, "")
+	return id
+}
+
+func (b *builder) setName(iso, name string) {
+	b.s.nameByID[b.countryID(iso)] = name
+}
+
+// addNetwork adds pfx (masked) unless an identical prefix was added before
+// or the options leave it out. registered is the registered country of the
+// record, "" if it has none.
+func (b *builder) addNetwork(pfx netip.Prefix, iso, registered string, src CodeSource) {
+	if src == SourceFallback && b.skipUnknown || pfx.Addr().Is4() && b.skipV4 || pfx.Addr().Is6() && b.skipV6 {
+		return
+	}
+	id := b.countryID(iso)
+	reg := id
+	if registered != "" {
+		reg = b.countryID(registered)
+	}
+	ni := netInfo{id: id, reg: reg, src: src, layer: b.layer}
+
+	pieces := b.s.overrides.cover.subtract(pfx)
+	if len(pieces) == 1 && pieces[0] == pfx {
+		if c, ok := b.carved[pfx]; ok {
+			// A piece of a wider network got here first.
+			b.setInfo(pfx, c.index, ni)
+			delete(b.carved, pfx)
+			return
+		}
+		b.s.add(pfx, ni)
+		return
+	}
+
+	b.shadowed = append(b.shadowed, shadowNet{prefix: pfx, info: ni})
+	if b.carved == nil {
+		b.carved = make(map[netip.Prefix]carvedPiece)
+	}
+	for _, p := range pieces {
+		// Pieces of nested networks coincide; the one cut from the more
+		// specific network is what the data says there.
+		if c, ok := b.carved[p]; ok {
+			if c.bits < pfx.Bits() {
+				b.setInfo(p, c.index, ni)
+				b.carved[p] = carvedPiece{bits: pfx.Bits(), index: c.index}
+			}
+			continue
+		}
+		index := len(b.s.v4)
+		if p.Addr().Is6() {
+			index = len(b.s.v6)
+		}
+		b.carved[p] = carvedPiece{bits: pfx.Bits(), index: index}
+		b.s.add(p, ni)
+	}
+}
+
+// carvedPiece is a piece of a network cut by overrides: the length of the
+// network it was cut from and its index in Store.v4 or Store.v6.
+type carvedPiece struct {
+	bits, index int
+}
+
+// setInfo replaces the data of the network p added at index.
+func (b *builder) setInfo(p netip.Prefix, index int, ni netInfo) {
+	if p.Addr().Is4() {
+		b.s.v4[index].info = ni
+		return
+	}
+	b.s.v6[index].info = ni
+}
+
+// finish adds the override networks as a layer of their own and sorts the
+// store. A single-source store takes ds as its layer's dataset.
+func (b *builder) finish(ds DatasetInfo) *Store {
+	s := b.s
+	if len(s.layers) == 1 && s.layers[0].Dataset.Type == "" {
+		s.layers[0].Dataset = ds
+	}
+
+	if n := len(s.overrides.items); n > 0 {
+		b.beginLayer(LayerOverrides, DatasetInfo{
+			Type:        LayerOverrides,
+			BuildTime:   time.Now().UTC(),
+			Description: fmt.Sprintf("%d active overrides", n),
+		})
+		s.overrides.pieces(func(p netip.Prefix, o Override) {
+			id := b.countryID(o.ISO)
+			s.add(p, netInfo{id: id, reg: id, src: SourceOverride, layer: b.layer})
+		})
+	}
+	s.stats.ActiveOverrides = len(s.overrides.items)
+	s.shadowed = newShadowSet(b.shadowed)
+	s.dataset = ds
+
+	s.finalize()
+	return s
+}
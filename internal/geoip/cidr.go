@@ -0,0 +1,108 @@
+package geoip
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// Network is a stored network with what the store knows about it.
+type Network struct {
+	Prefix     netip.Prefix
+	ISO        string
+	Source     CodeSource
+	Provenance string // layer the network came from
+}
+
+func (s *Store) network(p netip.Prefix, ni netInfo) Network {
+	return Network{
+		Prefix:     p,
+		ISO:        s.isoByID[ni.id],
+		Source:     ni.src,
+		Provenance: s.layers[ni.layer].Name,
+	}
+}
+
+// Span is a read-only view of consecutive stored networks, in address order.
+type Span struct {
+	s        *Store
+	from, to uint32 // network indices, see Store.byCountry
+}
+
+func (v Span) Len() int {
+	return int(v.to - v.from)
+}
+
+// At returns the i-th network of the view.
+func (v Span) At(i int) Network {
+	p, ni := v.s.netAt(v.from + uint32(i))
+	return v.s.network(p, ni)
+}
+
+// Slice returns the view of networks [from, to).
+func (v Span) Slice(from, to int) Span {
+	return Span{s: v.s, from: v.from + uint32(from), to: v.from + uint32(to)}
+}
+
+// NetworkOf returns the stored network equal to pfx (masked).
+func (s *Store) NetworkOf(pfx netip.Prefix) (Network, bool) {
+	pfx = pfx.Masked()
+	ni, ok := s.find(pfx)
+	if !ok {
+		return Network{}, false
+	}
+	return s.network(pfx, ni), true
+}
+
+// Supernets returns the stored networks strictly containing pfx, widest
+// first.
+func (s *Store) Supernets(pfx netip.Prefix) []Network {
+	pfx = pfx.Masked()
+	if !s.nested {
+		// At most one stored network contains the first address of pfx.
+		p, _, _, ok := s.Lookup(pfx.Addr())
+		if !ok || p.Bits() >= pfx.Bits() {
+			return nil
+		}
+		n, _ := s.NetworkOf(p)
+		return []Network{n}
+	}
+
+	var out []Network
+	for bits := 0; bits < pfx.Bits(); bits++ {
+		if n, ok := s.NetworkOf(netip.PrefixFrom(pfx.Addr(), bits)); ok {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Subnets returns the stored networks strictly inside pfx. Sorted by address
+// and then length they are one run: everything starting inside pfx that is
+// longer than pfx.
+func (s *Store) Subnets(pfx netip.Prefix) Span {
+	pfx = pfx.Masked()
+	bits := uint8(pfx.Bits())
+
+	if pfx.Addr().Is4() {
+		first, last := packV4(pfx.Addr()), packV4(lastAddr(pfx))
+		from := sort.Search(len(s.v4), func(i int) bool { return s.v4[i].compare(first, bits+1) >= 0 })
+		to := sort.Search(len(s.v4), func(i int) bool { return s.v4[i].addr > last })
+		if to < from {
+			to = from
+		}
+		return Span{s: s, from: uint32(from), to: uint32(to)}
+	}
+
+	hi, lo := packV6(pfx.Addr())
+	lastHi, lastLo := packV6(lastAddr(pfx))
+	from := sort.Search(len(s.v6), func(i int) bool { return s.v6[i].compare(hi, lo, bits+1) >= 0 })
+	to := sort.Search(len(s.v6), func(i int) bool {
+		x := s.v6[i]
+		return x.hi > lastHi || x.hi == lastHi && x.lo > lastLo
+	})
+	if to < from {
+		to = from
+	}
+	n := uint32(len(s.v4))
+	return Span{s: s, from: n + uint32(from), to: n + uint32(to)}
+}
@@ -0,0 +1,268 @@
+package geoip
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+)
+
+type CSVFormat string
+
+const (
+	// CSVGeoLite2 is the GeoLite2/GeoIP2 Country CSV edition: Blocks-IPv4 and
+	// Blocks-IPv6 files keyed by geoname_id plus a Locations file.
+	CSVGeoLite2 CSVFormat = "geolite2"
+	// CSVDBIP is the DB-IP lite country CSV: ip_start,ip_end,country.
+	CSVDBIP CSVFormat = "dbip"
+)
+
+// CSVSource describes a CSV dataset.
+type CSVSource struct {
+	Format    CSVFormat
+	Blocks    []string // blocks files (or DB-IP files), glob patterns allowed
+	Locations string   // GeoLite2 only
+}
+
+type csvLocation struct {
+	iso  string
+	name string
+}
+
+// LoadCSV builds a Store from a CSV edition of a country database.
+func LoadCSV(ctx context.Context, src CSVSource, opt Options) (*Store, error) {
+	if opt.UnknownISO == "" {
+		opt.UnknownISO = UnknownISO
+	}
+
+	files, err := ExpandPaths(src.Blocks)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no csv block files")
+	}
+
+	b := newBuilder(opt, LayerCSV, 600000, 500000)
+
+	var modTime time.Time
+	for _, path := range files {
+		if fi, err := os.Stat(path); err == nil && fi.ModTime().After(modTime) {
+			modTime = fi.ModTime().UTC()
+		}
+	}
+
+	switch src.Format {
+	case CSVGeoLite2:
+		locs, err := readGeoLite2Locations(src.Locations)
+		if err != nil {
+			return nil, fmt.Errorf("read locations %s: %w", src.Locations, err)
+		}
+		for _, l := range locs {
+			if l.iso != "" && l.name != "" {
+				b.setName(l.iso, l.name)
+			}
+		}
+		for _, path := range files {
+			if err := readGeoLite2Blocks(ctx, path, locs, opt.UnknownISO, b); err != nil {
+				return nil, fmt.Errorf("read blocks %s: %w", path, err)
+			}
+		}
+
+	case CSVDBIP:
+		for _, path := range files {
+			if err := readDBIP(ctx, path, opt.UnknownISO, b); err != nil {
+				return nil, fmt.Errorf("read dbip %s: %w", path, err)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported csv format %q", src.Format)
+	}
+
+	return b.finish(DatasetInfo{
+		Type:        "csv-" + string(src.Format),
+		BuildTime:   modTime,
+		Description: strings.Join(files, ","),
+	}), nil
+}
+
+// csvFile opens path and returns a reader plus the column index of the
+// header row.
+func csvFile(path string) (*os.File, *csv.Reader, map[string]int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	header, err := r.Read()
+	if err != nil {
+		f.Close()
+		return nil, nil, nil, fmt.Errorf("read header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, h := range header {
+		cols[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	return f, r, cols, nil
+}
+
+func requireColumns(cols map[string]int, names ...string) error {
+	for _, n := range names {
+		if _, ok := cols[n]; !ok {
+			return fmt.Errorf("missing column %q", n)
+		}
+	}
+	return nil
+}
+
+func field(rec []string, i int) string {
+	if i < 0 || i >= len(rec) {
+		return ""
+	}
+	return strings.TrimSpace(rec[i])
+}
+
+func readGeoLite2Locations(path string) (map[string]csvLocation, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, fmt.Errorf("locations file is required for %s", CSVGeoLite2)
+	}
+
+	f, r, cols, err := csvFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if err := requireColumns(cols, "geoname_id", "country_iso_code"); err != nil {
+		return nil, err
+	}
+	nameCol, ok := cols["country_name"]
+	if !ok {
+		nameCol = -1
+	}
+
+	out := make(map[string]csvLocation, 256)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		id := field(rec, cols["geoname_id"])
+		if id == "" {
+			continue
+		}
+		out[id] = csvLocation{
+			iso:  normalizeISO(field(rec, cols["country_iso_code"])),
+			name: field(rec, nameCol),
+		}
+	}
+	return out, nil
+}
+
+func readGeoLite2Blocks(ctx context.Context, path string, locs map[string]csvLocation, unknownISO string, b *builder) error {
+	f, r, cols, err := csvFile(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := requireColumns(cols, "network", "geoname_id", "registered_country_geoname_id"); err != nil {
+		return err
+	}
+	representedCol, ok := cols["represented_country_geoname_id"]
+	if !ok {
+		representedCol = -1
+	}
+
+	for n := 1; ; n++ {
+		if n%65536 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		pfx, err := netip.ParsePrefix(field(rec, cols["network"]))
+		if err != nil {
+			return fmt.Errorf("row %d: invalid network %q", n, field(rec, cols["network"]))
+		}
+
+		var row Record
+		row.Country.ISOCode = locs[field(rec, cols["geoname_id"])].iso
+		row.RegisteredCountry.ISOCode = locs[field(rec, cols["registered_country_geoname_id"])].iso
+		row.RepresentedCountry.ISOCode = locs[field(rec, representedCol)].iso
+
+		iso, src := ResolveWith(row, b.s.preference, unknownISO)
+		b.addNetwork(pfx.Masked(), iso, row.RegisteredCountry.ISOCode, src)
+	}
+}
+
+// readDBIP reads a DB-IP lite file: ip_start,ip_end,country without header.
+// DB-IP marks unknown space with "ZZ".
+func readDBIP(ctx context.Context, path, unknownISO string, b *builder) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	for n := 1; ; n++ {
+		if n%65536 == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(rec) < 3 {
+			return fmt.Errorf("row %d: expected ip_start,ip_end,country", n)
+		}
+
+		first, err1 := netip.ParseAddr(field(rec, 0))
+		last, err2 := netip.ParseAddr(field(rec, 1))
+		if err1 != nil || err2 != nil || first.Is4() != last.Is4() || last.Less(first) {
+			if n == 1 {
+				continue // header row
+			}
+			return fmt.Errorf("row %d: invalid range %q-%q", n, field(rec, 0), field(rec, 1))
+		}
+
+		iso, src := normalizeISO(field(rec, 2)), SourceCountry
+		if iso == "" || iso == UnknownISO {
+			iso, src = unknownISO, SourceFallback
+		}
+		for _, p := range rangeToPrefixes(first, last) {
+			b.addNetwork(p, iso, "", src)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// Trace is a step-by-step account of how an address was resolved. Match.Override
+// is set when a local override replaced the database answer.
+type Trace struct {
+	Address netip.Addr
+
+	// Alias describes the IPv4 address embedded in Address, if any.
+	Alias         AliasKind
+	EmbeddedIPv4  netip.Addr
+	AliasApplied  bool       // lookup used EmbeddedIPv4 instead of Address
+	LookupAddress netip.Addr // address actually looked up in the database
+
+	Match
+	RawRecord map[string]any // undecoded database record, nil if not found
+
+	// Database answer before overrides were applied.
+	DatabaseNetwork netip.Prefix
+	DatabaseISO     string
+
+	Steps []NormalizeStep
+
+	Dataset DatasetInfo
+}
+
+// NormalizeStep records how one record field went through normalizeISO.
+type NormalizeStep struct {
+	Field      string // country, registered_country, represented_country
+	Raw        string
+	Normalized string
+	Selected   bool // this field produced the final code
+}
+
+// DatasetInfo identifies the database build.
+type DatasetInfo struct {
+	Type        string
+	BuildTime   time.Time
+	Description string
+}
+
+func DatasetFromMetadata(md maxminddb.Metadata) DatasetInfo {
+	desc := md.Description["en"]
+	if desc == "" {
+		for _, v := range md.Description {
+			desc = v
+			break
+		}
+	}
+	return DatasetInfo{
+		Type:        md.DatabaseType,
+		BuildTime:   time.Unix(int64(md.BuildEpoch), 0).UTC(),
+		Description: desc,
+	}
+}
+
+// Explain performs the same lookup as Lookup and keeps every intermediate
+// result.
+func (r *Resolver) Explain(addr netip.Addr) (Trace, error) {
+	t := Trace{Address: addr}
+	if r.store != nil {
+		t.Dataset = r.store.Dataset()
+	}
+	t.EmbeddedIPv4, t.Alias = EmbeddedIPv4(addr)
+	lookup, alias := Unwrap(addr.WithZone(""), r.unwrap...)
+	t.LookupAddress, t.AliasApplied = lookup, alias != AliasNone
+
+	m, err := r.lookupDB(t.LookupAddress)
+	if err != nil {
+		return Trace{}, err
+	}
+
+	if r.fromDB(m) {
+		var raw map[string]any
+		if err := r.db.Lookup(t.LookupAddress.AsSlice(), &raw); err != nil {
+			return Trace{}, fmt.Errorf("lookup raw record %s: %w", t.LookupAddress, err)
+		}
+		t.RawRecord = raw
+	}
+
+	t.DatabaseNetwork, t.DatabaseISO = m.Network, m.ISO
+	r.applyOverride(&m, t.LookupAddress)
+	m.Address, m.Alias = t.LookupAddress, alias
+	m.Provenance = r.provenance(m)
+	t.Match = m
+
+	// Steps follow the order the fields are tried in.
+	for _, src := range r.preference() {
+		raw := m.Record.field(src)
+		t.Steps = append(t.Steps, NormalizeStep{
+			Field:      src.String(),
+			Raw:        raw,
+			Normalized: normalizeISO(raw),
+			Selected:   m.Source == src,
+		})
+	}
+
+	return t, nil
+}
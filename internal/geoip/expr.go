@@ -0,0 +1,313 @@
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Set expressions combine countries, groups and literal CIDRs:
+//
+//	EU + CH - (185.0.0.0/16 + 2001:db8::/32)
+//
+// "+" (or "|") is union, "&" intersection and "-" difference. "&" binds
+// tighter than "+" and "-", which are evaluated left to right. Identifiers
+// are case-insensitive; an IP address stands for its single-address prefix.
+const (
+	maxExprLen   = 4096
+	maxExprDepth = 64
+)
+
+// ExprError is a syntax or evaluation error at a position (0-based byte
+// offset) of the expression.
+type ExprError struct {
+	Pos int
+	Msg string
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos+1)
+}
+
+// Expr is a parsed set expression.
+type Expr struct {
+	root *exprNode
+}
+
+type exprNode struct {
+	op    byte // '+', '&' or '-'; 0 for an operand
+	l, r  *exprNode
+	pos   int
+	ident string       // upper-cased identifier operand
+	lit   netip.Prefix // literal operand, when ident is ""
+}
+
+type exprToken struct {
+	pos  int
+	text string // "" at the end of the input
+}
+
+func tokenizeExpr(s string) ([]exprToken, error) {
+	var toks []exprToken
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case strings.IndexByte("()+|&-", c) >= 0:
+			toks = append(toks, exprToken{pos: i, text: s[i : i+1]})
+			i++
+		case isExprWordByte(c):
+			start := i
+			for i < len(s) && isExprWordByte(s[i]) {
+				i++
+			}
+			toks = append(toks, exprToken{pos: start, text: s[start:i]})
+		default:
+			return nil, &ExprError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(toks, exprToken{pos: len(s)}), nil
+}
+
+func isExprWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '.' || c == ':' || c == '/'
+}
+
+// ParseExpr parses s. Identifiers are not resolved until Eval.
+func ParseExpr(s string) (*Expr, error) {
+	if len(s) > maxExprLen {
+		return nil, &ExprError{Pos: maxExprLen, Msg: fmt.Sprintf("expression is longer than %d characters", maxExprLen)}
+	}
+	toks, err := tokenizeExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(toks) == 1 {
+		return nil, &ExprError{Pos: 0, Msg: "empty expression"}
+	}
+
+	p := &exprParser{toks: toks}
+	root, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.text != "" {
+		if t.text == ")" {
+			return nil, &ExprError{Pos: t.pos, Msg: "unmatched ')'"}
+		}
+		return nil, &ExprError{Pos: t.pos, Msg: fmt.Sprintf("expected operator, got %q", t.text)}
+	}
+	return &Expr{root: root}, nil
+}
+
+type exprParser struct {
+	toks []exprToken
+	i    int
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.toks[p.i]
+}
+
+func (p *exprParser) next() exprToken {
+	t := p.toks[p.i]
+	if t.text != "" {
+		p.i++
+	}
+	return t
+}
+
+// expr := term { ("+" | "|" | "-") term }
+func (p *exprParser) expr(depth int) (*exprNode, error) {
+	n, err := p.term(depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.text != "+" && t.text != "|" && t.text != "-" {
+			return n, nil
+		}
+		p.next()
+		r, err := p.term(depth)
+		if err != nil {
+			return nil, err
+		}
+		op := t.text[0]
+		if op == '|' {
+			op = '+'
+		}
+		n = &exprNode{op: op, l: n, r: r, pos: t.pos}
+	}
+}
+
+// term := factor { "&" factor }
+func (p *exprParser) term(depth int) (*exprNode, error) {
+	n, err := p.factor(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().text == "&" {
+		t := p.next()
+		r, err := p.factor(depth)
+		if err != nil {
+			return nil, err
+		}
+		n = &exprNode{op: '&', l: n, r: r, pos: t.pos}
+	}
+	return n, nil
+}
+
+// factor := "(" expr ")" | identifier | CIDR | address
+func (p *exprParser) factor(depth int) (*exprNode, error) {
+	t := p.next()
+	switch t.text {
+	case "":
+		return nil, &ExprError{Pos: t.pos, Msg: "expected country, group or CIDR, got end of expression"}
+	case "(":
+		if depth >= maxExprDepth {
+			return nil, &ExprError{Pos: t.pos, Msg: fmt.Sprintf("parentheses nested deeper than %d", maxExprDepth)}
+		}
+		n, err := p.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		if c := p.next(); c.text != ")" {
+			return nil, &ExprError{Pos: t.pos, Msg: "unclosed '('"}
+		}
+		return n, nil
+	case ")", "+", "|", "&", "-":
+		return nil, &ExprError{Pos: t.pos, Msg: fmt.Sprintf("expected country, group or CIDR, got %q", t.text)}
+	}
+
+	if !strings.ContainsAny(t.text, ".:/") {
+		return &exprNode{ident: strings.ToUpper(t.text), pos: t.pos}, nil
+	}
+	pfx, err := parseExprLiteral(t.text)
+	if err != nil {
+		return nil, &ExprError{Pos: t.pos, Msg: fmt.Sprintf("invalid CIDR %q", t.text)}
+	}
+	return &exprNode{lit: pfx, pos: t.pos}, nil
+}
+
+func parseExprLiteral(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		a, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		a = a.Unmap()
+		return netip.PrefixFrom(a, a.BitLen()), nil
+	}
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if a := p.Addr(); a.Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(a.Unmap(), p.Bits()-96)
+	}
+	return p.Masked(), nil
+}
+
+// Identifiers returns the distinct identifiers of e, in order of appearance.
+func (e *Expr) Identifiers() []string {
+	var out []string
+	seen := make(map[string]bool)
+	var walk func(n *exprNode)
+	walk = func(n *exprNode) {
+		if n.op != 0 {
+			walk(n.l)
+			walk(n.r)
+			return
+		}
+		if n.ident != "" && !seen[n.ident] {
+			seen[n.ident] = true
+			out = append(out, n.ident)
+		}
+	}
+	walk(e.root)
+	return out
+}
+
+// Eval computes the set e denotes. resolve returns the set an identifier
+// stands for, or false if it is unknown.
+func (e *Expr) Eval(resolve func(ident string) (NetSet, bool)) (NetSet, error) {
+	return e.root.eval(resolve)
+}
+
+func (n *exprNode) eval(resolve func(string) (NetSet, bool)) (NetSet, error) {
+	switch n.op {
+	case 0:
+		if n.ident == "" {
+			return NewNetSet(n.lit), nil
+		}
+		set, ok := resolve(n.ident)
+		if !ok {
+			return NetSet{}, &ExprError{Pos: n.pos, Msg: fmt.Sprintf("unknown country or group %q", n.ident)}
+		}
+		return set, nil
+	}
+
+	l, err := n.l.eval(resolve)
+	if err != nil {
+		return NetSet{}, err
+	}
+	r, err := n.r.eval(resolve)
+	if err != nil {
+		return NetSet{}, err
+	}
+	switch n.op {
+	case '&':
+		return l.Intersect(r), nil
+	case '-':
+		return l.Subtract(r), nil
+	default:
+		return l.Union(r), nil
+	}
+}
+
+// String returns e in canonical form, with only the parentheses it needs.
+func (e *Expr) String() string {
+	var b strings.Builder
+	e.root.format(&b, 0)
+	return b.String()
+}
+
+func (n *exprNode) prec() int {
+	switch n.op {
+	case 0:
+		return 3
+	case '&':
+		return 2
+	default:
+		return 1
+	}
+}
+
+// format writes n; min is the precedence below which n needs parentheses.
+func (n *exprNode) format(b *strings.Builder, min int) {
+	if n.op == 0 {
+		if n.ident != "" {
+			b.WriteString(n.ident)
+		} else {
+			b.WriteString(n.lit.String())
+		}
+		return
+	}
+
+	paren := n.prec() < min
+	if paren {
+		b.WriteByte('(')
+	}
+	n.l.format(b, n.prec())
+	b.WriteByte(' ')
+	b.WriteByte(n.op)
+	b.WriteByte(' ')
+	// Left-associative: a right operand of the same precedence is grouped.
+	n.r.format(b, n.prec()+1)
+	if paren {
+		b.WriteByte(')')
+	}
+}
@@ -0,0 +1,105 @@
+package geoip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// Layer names recorded as network provenance.
+const (
+	LayerMMDB      = "mmdb"
+	LayerRIR       = "rir"
+	LayerCSV       = "csv"
+	LayerOverrides = "overrides"
+)
+
+// SourceInfo describes one layer of a store.
+type SourceInfo struct {
+	Name     string
+	Dataset  DatasetInfo
+	Networks int // networks the layer contributes after precedence
+}
+
+// Layer is one source of a layered dataset.
+type Layer struct {
+	Name string
+	Load func(ctx context.Context, opt Options) (*Store, error)
+}
+
+// LoadLayered loads every layer and merges them into one store. Layers are
+// given in precedence order: an address is answered by the first layer that
+// resolves a country for it, and fallback networks only fill space no layer
+// covers. Overrides from opt are applied on top of all layers.
+func LoadLayered(ctx context.Context, layers []Layer, opt Options) (*Store, error) {
+	if len(layers) == 0 {
+		return nil, errors.New("no layers configured")
+	}
+
+	layerOpt := opt
+	layerOpt.Overrides = nil
+
+	stores := make([]*Store, len(layers))
+	for i, l := range layers {
+		st, err := l.Load(ctx, layerOpt)
+		if err != nil {
+			return nil, fmt.Errorf("load layer %s: %w", l.Name, err)
+		}
+		stores[i] = st
+	}
+
+	var sizeV4, sizeV6 int
+	for _, st := range stores {
+		sizeV4 += len(st.v4)
+		sizeV6 += len(st.v6)
+	}
+
+	b := newBuilder(opt, "", sizeV4, sizeV6)
+	names := make([]string, 0, len(layers))
+	ds := DatasetInfo{Type: "layered"}
+	for i, st := range stores {
+		b.beginLayer(layers[i].Name, st.Dataset())
+		names = append(names, layers[i].Name)
+		if t := st.Dataset().BuildTime; t.After(ds.BuildTime) {
+			ds.BuildTime = t
+		}
+		for id, name := range st.nameByID {
+			if iso := st.isoByID[id]; name != "" && b.s.CountryName(iso) == "" {
+				b.setName(iso, name)
+			}
+		}
+	}
+	ds.Description = strings.Join(names, " > ")
+
+	// Resolved networks first, layer by layer, each carved by everything the
+	// layers above it claimed; then fallback networks into what is left.
+	var claimed []netip.Prefix
+	for _, fallback := range []bool{false, true} {
+		for i, st := range stores {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			b.layer = uint8(i)
+			cover := newCoverSet(claimed)
+			claimed = cover.list()
+
+			st.forEach(func(p netip.Prefix, ni netInfo) {
+				if (ni.src == SourceFallback) != fallback {
+					return
+				}
+				iso, reg := st.isoByID[ni.id], st.isoByID[ni.reg]
+				for _, q := range cover.subtract(p) {
+					b.addNetwork(q, iso, reg, ni.src)
+				}
+				claimed = append(claimed, p)
+			})
+		}
+	}
+
+	return b.finish(ds), nil
+}
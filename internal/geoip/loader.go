@@ -17,13 +17,71 @@ type CountryInfo struct {
 }
 
 type Record struct {
-	Country           CountryInfo `maxminddb:"country"`
-	RegisteredCountry CountryInfo `maxminddb:"registered_country"`
+	Country            CountryInfo `maxminddb:"country"`
+	RegisteredCountry  CountryInfo `maxminddb:"registered_country"`
+	RepresentedCountry CountryInfo `maxminddb:"represented_country"`
+}
+
+// CodeSource tells which record field produced the resolved country code.
+type CodeSource uint8
+
+const (
+	SourceCountry CodeSource = iota
+	SourceRegisteredCountry
+	SourceRepresentedCountry
+	SourceFallback
+	SourceOverride
+)
+
+func (s CodeSource) String() string {
+	switch s {
+	case SourceCountry:
+		return "country"
+	case SourceRegisteredCountry:
+		return "registered_country"
+	case SourceRepresentedCountry:
+		return "represented_country"
+	case SourceOverride:
+		return "override"
+	default:
+		return "fallback"
+	}
+}
+
+// DefaultPreference is the order record fields are tried in unless
+// Options.Preference says otherwise.
+var DefaultPreference = []CodeSource{SourceCountry, SourceRegisteredCountry, SourceRepresentedCountry}
+
+// ParseCodeSource parses the name of a record field as printed by
+// CodeSource.String: country, registered_country or represented_country.
+func ParseCodeSource(name string) (CodeSource, bool) {
+	for _, src := range DefaultPreference {
+		if src.String() == name {
+			return src, true
+		}
+	}
+	return 0, false
 }
 
 type Options struct {
 	SkipAliasedNetworks bool
 	UnknownISO          string // fallback, "ZZ" code
+
+	// Preference lists the record fields a network's country is taken
+	// from, first non-empty wins; nil means DefaultPreference. Fields left
+	// out are never used.
+	Preference []CodeSource
+	// SkipUnknown leaves networks without a country out of the store, so
+	// they are not listed under UnknownISO. Addresses in them still
+	// resolve to UnknownISO.
+	SkipUnknown bool
+	// SkipIPv4 and SkipIPv6 load one address family only.
+	SkipIPv4 bool
+	SkipIPv6 bool
+
+	// Overrides are applied on top of the database; the most specific one
+	// wins. Overrides already expired at load time are ignored.
+	Overrides []Override
 }
 
 func DefaultOptions() Options {
@@ -41,25 +99,7 @@ func Load(ctx context.Context, mmdbPath string, opt Options) (*Store, error) {
 	}
 	defer db.Close()
 
-	s := &Store{
-		isoByID:   make([]string, 0, 256),
-		idByISO:   make(map[string]CountryID, 256),
-		byCountry: make([][]netip.Prefix, 0, 256),
-
-		byV4: make(map[v4Key]CountryID, 600000),
-		byV6: make(map[netip.Prefix]CountryID, 500000),
-	}
-
-	getOrCreateID := func(iso string) CountryID {
-		if id, ok := s.idByISO[iso]; ok {
-			return id
-		}
-		id := CountryID(len(s.isoByID))
-		s.idByISO[iso] = id
-		s.isoByID = append(s.isoByID, iso)
-		s.byCountry = append(s.byCountry, nil)
-		return id
-	}
+	b := newBuilder(opt, LayerMMDB, 600000, 500000)
 
 	var iter *maxminddb.Networks
 	if opt.SkipAliasedNetworks {
@@ -81,40 +121,54 @@ func Load(ctx context.Context, mmdbPath string, opt Options) (*Store, error) {
 			return nil, fmt.Errorf("iterate network: %w", err)
 		}
 
-		iso := normalizeISO(rec.Country.ISOCode)
-		if iso == "" {
-			iso = normalizeISO(rec.RegisteredCountry.ISOCode)
-		}
-		if iso == "" {
-			iso = opt.UnknownISO
-		}
+		iso, src := ResolveWith(rec, opt.Preference, opt.UnknownISO)
 
 		pfx, err := ipNetToPrefix(ipNet)
 		if err != nil {
 			return nil, fmt.Errorf("convert network %v: %w", ipNet, err)
 		}
 
-		pfx = pfx.Masked()
-		id := getOrCreateID(iso)
-
-		s.byCountry[id] = append(s.byCountry[id], pfx)
-
-		s.stats.TotalNetworks++
-		if pfx.Addr().Is4() {
-			s.stats.V4Networks++
-			s.byV4[makeV4Key(pfx)] = id
-		} else {
-			s.stats.V6Networks++
-			s.byV6[pfx] = id
-		}
+		b.addNetwork(pfx.Masked(), iso, normalizeISO(rec.RegisteredCountry.ISOCode), src)
 	}
 
 	if err := iter.Err(); err != nil {
 		return nil, fmt.Errorf("iterator error: %w", err)
 	}
 
-	s.finalize()
-	return s, nil
+	return b.finish(DatasetFromMetadata(db.Metadata)), nil
+}
+
+// Resolve picks the country code for rec: country, then registered_country,
+// then represented_country, then unknownISO.
+func Resolve(rec Record, unknownISO string) (string, CodeSource) {
+	return ResolveWith(rec, DefaultPreference, unknownISO)
+}
+
+// ResolveWith is Resolve with the fields tried in the order of pref; nil
+// means DefaultPreference.
+func ResolveWith(rec Record, pref []CodeSource, unknownISO string) (string, CodeSource) {
+	if pref == nil {
+		pref = DefaultPreference
+	}
+	for _, src := range pref {
+		if iso := normalizeISO(rec.field(src)); iso != "" {
+			return iso, src
+		}
+	}
+	return unknownISO, SourceFallback
+}
+
+// field returns the raw code of the record field src names.
+func (r Record) field(src CodeSource) string {
+	switch src {
+	case SourceCountry:
+		return r.Country.ISOCode
+	case SourceRegisteredCountry:
+		return r.RegisteredCountry.ISOCode
+	case SourceRepresentedCountry:
+		return r.RepresentedCountry.ISOCode
+	}
+	return ""
 }
 
 func normalizeISO(s string) string {
@@ -138,7 +192,13 @@ func ipNetToPrefix(n *net.IPNet) (netip.Prefix, error) {
 		return netip.Prefix{}, fmt.Errorf("nil ip")
 	}
 
-	if ip4 := ip.To4(); ip4 != nil {
+	// Decide by mask size, not by To4(): aliased networks such as
+	// ::ffff:0:0/96 carry 16-byte IPv4-mapped addresses with a 128-bit mask.
+	if bits == 8*net.IPv4len {
+		ip4 := ip.To4()
+		if ip4 == nil {
+			return netip.Prefix{}, fmt.Errorf("bad ipv4 ip: %v", ip)
+		}
 		var a4 [4]byte
 		copy(a4[:], ip4)
 		return netip.PrefixFrom(netip.AddrFrom4(a4), ones), nil
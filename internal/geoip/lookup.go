@@ -0,0 +1,190 @@
+package geoip
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+// Match is the outcome of a single address lookup.
+type Match struct {
+	// Address is the address looked up: the one asked for, or the IPv4
+	// address unwrapped from it, as Alias tells.
+	Address netip.Addr
+	Alias   AliasKind
+
+	Found   bool
+	Network netip.Prefix // database network (or override) that contains the address
+	Record  Record
+
+	ISO    string
+	Source CodeSource
+	// Registered is the normalized registered_country code, "" if absent.
+	// Store lookups report the network's country when it has none.
+	Registered string
+
+	Override *Override // override that replaced the database answer
+
+	// Provenance names the store layer that answered (mmdb, rir, csv or
+	// overrides); "" if the address is not covered.
+	Provenance string
+}
+
+// RegisteredDiffers reports whether registered_country is present and differs
+// from the resolved code.
+func (m Match) RegisteredDiffers() bool {
+	return m.Registered != "" && m.Registered != m.ISO
+}
+
+// Resolver answers address lookups the same way Load resolves networks:
+// database record first, then local overrides on top. Without a database
+// reader (non-MMDB sources) the store itself is searched.
+type Resolver struct {
+	db    *maxminddb.Reader
+	store *Store
+	// layered: the reader is the MMDB layer of a layered store and answers
+	// only the addresses that layer won.
+	layered bool
+	unwrap  []AliasKind
+}
+
+// NewResolver returns a resolver that looks up 6to4 and Teredo addresses
+// of the kinds in unwrap by their embedded IPv4 address. IPv4-mapped
+// addresses are always looked up as IPv4, as both the database reader and
+// the store do. When store merges other layers besides the MMDB one, db
+// only answers where the MMDB layer won.
+func NewResolver(db *maxminddb.Reader, store *Store, unwrap ...AliasKind) *Resolver {
+	r := &Resolver{db: db, store: store, unwrap: append([]AliasKind{AliasIPv4Mapped}, unwrap...)}
+	if db != nil && store != nil {
+		for _, l := range store.Sources() {
+			r.layered = r.layered || l.Name != LayerMMDB && l.Name != LayerOverrides
+		}
+	}
+	return r
+}
+
+func (r *Resolver) unknownISO() string {
+	if r.store == nil {
+		return UnknownISO
+	}
+	return r.store.UnknownISO()
+}
+
+func (r *Resolver) preference() []CodeSource {
+	if r.store == nil {
+		return DefaultPreference
+	}
+	return r.store.Preference()
+}
+
+func (r *Resolver) Lookup(addr netip.Addr) (Match, error) {
+	addr, alias := Unwrap(addr.WithZone(""), r.unwrap...)
+	m, err := r.lookupDB(addr)
+	if err != nil {
+		return Match{}, err
+	}
+	r.applyOverride(&m, addr)
+	m.Address, m.Alias = addr, alias
+	m.Provenance = r.provenance(m)
+	return m, nil
+}
+
+func (r *Resolver) lookupDB(addr netip.Addr) (Match, error) {
+	var m Match
+	if r.db == nil {
+		return r.lookupStore(addr), nil
+	}
+
+	var layer Match
+	if r.layered {
+		layer = r.lookupStore(addr)
+		if !r.fromDB(layer) {
+			return layer, nil
+		}
+	}
+
+	ipNet, ok, err := r.db.LookupNetwork(addr.AsSlice(), &m.Record)
+	if err != nil {
+		return Match{}, fmt.Errorf("lookup %s: %w", addr, err)
+	}
+	if !ok && r.layered {
+		return layer, nil
+	}
+	m.Found = ok
+
+	if ok && ipNet != nil {
+		pfx, err := ipNetToPrefix(ipNet)
+		if err != nil {
+			return Match{}, fmt.Errorf("convert network %v: %w", ipNet, err)
+		}
+		m.Network = pfx.Masked()
+	}
+	if r.layered {
+		// The layer may have won only part of the record's network.
+		m.Network = layer.Network
+	}
+
+	m.ISO, m.Source = ResolveWith(m.Record, r.preference(), r.unknownISO())
+	m.Registered = normalizeISO(m.Record.RegisteredCountry.ISOCode)
+	return m, nil
+}
+
+func (r *Resolver) lookupStore(addr netip.Addr) Match {
+	var m Match
+	m.ISO, m.Source = r.unknownISO(), SourceFallback
+	if r.store == nil {
+		return m
+	}
+
+	pfx, iso, src, ok := r.store.Lookup(addr)
+	if ok && src == SourceOverride {
+		// Overrides are applied separately so the database answer stays
+		// visible: the network the override took the address from.
+		pfx, iso, src, ok = r.store.Shadowed(addr)
+	}
+	if !ok {
+		return m
+	}
+	m.Found, m.Network, m.ISO, m.Source = true, pfx, iso, src
+	m.Registered = r.store.RegisteredOf(pfx)
+	return m
+}
+
+// fromDB reports whether the database reader answers for the store match
+// m, or for every address when the store was loaded from it alone.
+func (r *Resolver) fromDB(m Match) bool {
+	if r.db == nil || !m.Found {
+		return false
+	}
+	return !r.layered || r.store.ProvenanceOf(m.Network) == LayerMMDB
+}
+
+func (r *Resolver) provenance(m Match) string {
+	switch {
+	case m.Override != nil:
+		return LayerOverrides
+	case !m.Found || r.store == nil:
+		return ""
+	case r.db != nil && !r.layered:
+		// The reader alone answers for a store loaded from it.
+		return LayerMMDB
+	default:
+		return r.store.ProvenanceOf(m.Network)
+	}
+}
+
+func (r *Resolver) applyOverride(m *Match, addr netip.Addr) {
+	if r.store == nil {
+		return
+	}
+	o, ok := r.store.OverrideFor(addr)
+	if !ok {
+		return
+	}
+	m.Found = true
+	m.Network = o.Prefix
+	m.ISO = o.ISO
+	m.Source = SourceOverride
+	m.Override = &o
+}
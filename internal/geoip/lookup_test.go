@@ -0,0 +1,66 @@
+package geoip
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+func TestResolverLayeredReader(t *testing.T) {
+	b := newBuilder(DefaultOptions(), LayerMMDB, 1, 1)
+	b.addNetwork(netip.MustParsePrefix("5.0.0.0/8"), "DE", "NL", SourceCountry)
+	path := writeTestMMDB(t, b.finish(DatasetInfo{Type: "test"}), DefaultMMDBOptions())
+
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+
+	// The RIR layer takes precedence over the MMDB one for 5.1.0.0/16.
+	s, err := LoadLayered(context.Background(), []Layer{
+		{Name: LayerRIR, Load: func(context.Context, Options) (*Store, error) {
+			return buildTestStore(t, []testNetwork{{"5.1.0.0/16", "FR", SourceCountry}}, nil), nil
+		}},
+		{Name: LayerMMDB, Load: func(ctx context.Context, opt Options) (*Store, error) {
+			return Load(ctx, path, opt)
+		}},
+	}, DefaultOptions())
+	if err != nil {
+		t.Fatalf("LoadLayered: %v", err)
+	}
+	r := NewResolver(db, s)
+
+	tests := []struct {
+		addr       string
+		iso        string
+		registered string
+		network    string
+		provenance string
+		record     bool // answered with the reader's record
+	}{
+		{"5.2.0.1", "DE", "NL", "5.2.0.0/15", LayerMMDB, true},
+		{"5.1.0.1", "FR", "FR", "5.1.0.0/16", LayerRIR, false},
+		{"6.0.0.1", UnknownISO, "", "invalid Prefix", "", false},
+	}
+	for _, tt := range tests {
+		tr, err := r.Explain(netip.MustParseAddr(tt.addr))
+		if err != nil {
+			t.Fatalf("Explain(%s): %v", tt.addr, err)
+		}
+		m := tr.Match
+		if m.ISO != tt.iso || m.Registered != tt.registered || m.Network.String() != tt.network || m.Provenance != tt.provenance {
+			t.Errorf("Explain(%s) = %s registered %q in %s from %q; want %s registered %q in %s from %q",
+				tt.addr, m.ISO, m.Registered, m.Network, m.Provenance, tt.iso, tt.registered, tt.network, tt.provenance)
+		}
+		if got := tr.RawRecord != nil && m.Record.Country.ISOCode != ""; got != tt.record {
+			t.Errorf("Explain(%s) answered with a record = %v, want %v", tt.addr, got, tt.record)
+		}
+	}
+
+	if m, _ := r.Lookup(netip.MustParseAddr("5.2.0.1")); !m.RegisteredDiffers() {
+		t.Errorf("Lookup(5.2.0.1) registered %q, code %s: want registered_differs", m.Registered, m.ISO)
+	}
+}
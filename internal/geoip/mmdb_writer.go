@@ -0,0 +1,442 @@
+package geoip
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+	"time"
+)
+
+// MMDBOptions controls the metadata and layout of a written database.
+type MMDBOptions struct {
+	DatabaseType string    // default "GeoIP2-Country"
+	Description  string    // default: derived from the store's dataset
+	BuildTime    time.Time // default: now
+
+	// IPv4Aliases links ::ffff:0:0/96, 2002::/16 and 2001::/32 to the IPv4
+	// tree, as MaxMind databases do. Alias ranges that already hold IPv6
+	// networks are left alone.
+	IPv4Aliases bool
+}
+
+func DefaultMMDBOptions() MMDBOptions {
+	return MMDBOptions{DatabaseType: "GeoIP2-Country", IPv4Aliases: true}
+}
+
+// MaxMind DB data section types.
+const (
+	mmdbString = 2
+	mmdbMap    = 7
+	mmdbUint16 = 5
+	mmdbUint32 = 6
+	mmdbUint64 = 9
+	mmdbArray  = 11
+)
+
+const (
+	mmdbSeparatorLen = 16
+	mmdbMetaMarker   = "\xab\xcd\xefMaxMind.com"
+)
+
+var (
+	ipv4Subtree = netip.MustParsePrefix("::/96")
+	ipv4Aliases = []netip.Prefix{
+		netip.MustParsePrefix("::ffff:0:0/96"),
+		netip.MustParsePrefix("2002::/16"),
+		netip.MustParsePrefix("2001::/32"),
+	}
+)
+
+// WriteMMDB serializes the store as an IPv6 MaxMind DB with country records
+// in the GeoIP2-Country layout. Networks resolved from registered or
+// represented countries keep that field; fallback networks get an empty
+// record, so reading the file back with the same UnknownISO restores them.
+// IPv4 networks live under ::/96, and IPv6 networks overlapping it are
+// clipped.
+func (s *Store) WriteMMDB(w io.Writer, opt MMDBOptions) error {
+	if opt.DatabaseType == "" {
+		opt.DatabaseType = "GeoIP2-Country"
+	}
+	if opt.BuildTime.IsZero() {
+		opt.BuildTime = time.Now()
+	}
+	if opt.Description == "" {
+		opt.Description = "geocoder country database"
+		if ds := s.dataset; ds.Type != "" {
+			opt.Description += " built from " + ds.Type
+		}
+	}
+
+	type entry struct {
+		pfx netip.Prefix
+		ni  netInfo
+	}
+	var v4, v6 []entry
+	s.forEach(func(p netip.Prefix, ni netInfo) {
+		if p.Addr().Is4() {
+			v4 = append(v4, entry{p, ni})
+		} else {
+			v6 = append(v6, entry{p, ni})
+		}
+	})
+	// Shorter prefixes first, so that more specific networks split them.
+	byLength := func(a, b entry) int {
+		if c := cmp.Compare(a.pfx.Bits(), b.pfx.Bits()); c != 0 {
+			return c
+		}
+		return a.pfx.Addr().Compare(b.pfx.Addr())
+	}
+	slices.SortFunc(v4, byLength)
+	slices.SortFunc(v6, byLength)
+
+	t := &mmdbTree{}
+	root := t.newNode()
+	data := newMMDBData(s)
+
+	v4root := -1
+	if len(v4) > 0 {
+		v4root = t.newNode()
+		for _, e := range v4 {
+			t.insert(v4root, e.pfx, data.value(e.ni))
+		}
+		t.link(root, ipv4Subtree, v4root)
+	}
+
+	reserved := newCoverSet([]netip.Prefix{ipv4Subtree})
+	for _, e := range v6 {
+		for _, p := range reserved.subtract(e.pfx) {
+			t.insert(root, p, data.value(e.ni))
+		}
+	}
+
+	if opt.IPv4Aliases && v4root > 0 {
+		for _, p := range ipv4Aliases {
+			t.link(root, p, v4root)
+		}
+	}
+
+	return t.write(w, data, opt, len(data.names) > 0)
+}
+
+const (
+	recEmpty uint8 = iota
+	recNode
+	recData
+)
+
+type mmdbRecord struct {
+	kind uint8
+	val  int // node index or data value index
+}
+
+// mmdbTree is a binary search tree over address bits; node 0 is the root.
+type mmdbTree struct {
+	nodes [][2]mmdbRecord
+}
+
+func (t *mmdbTree) newNode() int {
+	t.nodes = append(t.nodes, [2]mmdbRecord{})
+	return len(t.nodes) - 1
+}
+
+// insert stores rec for pfx below node root, splitting less specific data
+// records on the way.
+func (t *mmdbTree) insert(root int, pfx netip.Prefix, rec mmdbRecord) {
+	if pfx.Bits() == 0 {
+		lo, hi := halves(pfx)
+		t.insert(root, lo, rec)
+		t.insert(root, hi, rec)
+		return
+	}
+
+	addr := pfx.Addr().AsSlice()
+	n := root
+	for depth := 0; depth < pfx.Bits()-1; depth++ {
+		bit := addrBit(addr, depth)
+		cur := t.nodes[n][bit]
+		if cur.kind == recNode {
+			n = cur.val
+			continue
+		}
+		child := t.newNode()
+		if cur.kind == recData {
+			t.nodes[child] = [2]mmdbRecord{cur, cur}
+		}
+		t.nodes[n][bit] = mmdbRecord{kind: recNode, val: child}
+		n = child
+	}
+	t.nodes[n][addrBit(addr, pfx.Bits()-1)] = rec
+}
+
+// link points the record for pfx at node target, unless the range already
+// holds data.
+func (t *mmdbTree) link(root int, pfx netip.Prefix, target int) {
+	addr := pfx.Addr().AsSlice()
+	n := root
+	for depth := 0; depth < pfx.Bits()-1; depth++ {
+		bit := addrBit(addr, depth)
+		cur := t.nodes[n][bit]
+		switch cur.kind {
+		case recData:
+			return
+		case recNode:
+			n = cur.val
+		default:
+			child := t.newNode()
+			t.nodes[n][bit] = mmdbRecord{kind: recNode, val: child}
+			n = child
+		}
+	}
+
+	bit := addrBit(addr, pfx.Bits()-1)
+	if t.nodes[n][bit].kind == recEmpty {
+		t.nodes[n][bit] = mmdbRecord{kind: recNode, val: target}
+	}
+}
+
+func addrBit(addr []byte, i int) int {
+	return int(addr[i/8]>>(7-i%8)) & 1
+}
+
+func (t *mmdbTree) write(w io.Writer, data *mmdbData, opt MMDBOptions, names bool) error {
+	// Encode only the values the tree still points to, in tree order: the
+	// data section must not hold unreferenced records.
+	offsets := make([]int, len(data.values))
+	for i := range offsets {
+		offsets[i] = -1
+	}
+	var section mmdbEncoder
+	for _, nd := range t.nodes {
+		for _, r := range nd {
+			if r.kind == recData && offsets[r.val] < 0 {
+				offsets[r.val] = len(section.buf)
+				data.encode(&section, data.values[r.val])
+			}
+		}
+	}
+
+	nodeCount := len(t.nodes)
+	maxValue := nodeCount + mmdbSeparatorLen + len(section.buf)
+	recordSize := 32
+	switch {
+	case maxValue < 1<<24:
+		recordSize = 24
+	case maxValue < 1<<28:
+		recordSize = 28
+	case maxValue >= 1<<32:
+		return fmt.Errorf("mmdb too large: %d nodes, %d data bytes", nodeCount, len(section.buf))
+	}
+
+	value := func(r mmdbRecord) uint32 {
+		switch r.kind {
+		case recNode:
+			return uint32(r.val)
+		case recData:
+			return uint32(nodeCount + mmdbSeparatorLen + offsets[r.val])
+		default:
+			return uint32(nodeCount)
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	var buf [8]byte
+	for _, nd := range t.nodes {
+		l, r := value(nd[0]), value(nd[1])
+		switch recordSize {
+		case 24:
+			buf = [8]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 16), byte(r >> 8), byte(r)}
+		case 28:
+			buf = [8]byte{byte(l >> 16), byte(l >> 8), byte(l), byte(l>>24)<<4 | byte(r>>24)&0x0f, byte(r >> 16), byte(r >> 8), byte(r)}
+		default:
+			buf = [8]byte{byte(l >> 24), byte(l >> 16), byte(l >> 8), byte(l), byte(r >> 24), byte(r >> 16), byte(r >> 8), byte(r)}
+		}
+		bw.Write(buf[:recordSize/4])
+	}
+
+	bw.Write(make([]byte, mmdbSeparatorLen))
+	bw.Write(section.buf)
+	bw.WriteString(mmdbMetaMarker)
+
+	var languages []string
+	if names {
+		languages = []string{"en"}
+	}
+	var meta mmdbEncoder
+	meta.mapHeader(9)
+	meta.string("binary_format_major_version")
+	meta.uint(mmdbUint16, 2)
+	meta.string("binary_format_minor_version")
+	meta.uint(mmdbUint16, 0)
+	meta.string("build_epoch")
+	meta.uint(mmdbUint64, uint64(opt.BuildTime.Unix()))
+	meta.string("database_type")
+	meta.string(opt.DatabaseType)
+	meta.string("description")
+	meta.mapHeader(1)
+	meta.string("en")
+	meta.string(opt.Description)
+	meta.string("ip_version")
+	meta.uint(mmdbUint16, 6)
+	meta.string("languages")
+	meta.arrayHeader(len(languages))
+	for _, l := range languages {
+		meta.string(l)
+	}
+	meta.string("node_count")
+	meta.uint(mmdbUint32, uint64(nodeCount))
+	meta.string("record_size")
+	meta.uint(mmdbUint16, uint64(recordSize))
+	bw.Write(meta.buf)
+
+	return bw.Flush()
+}
+
+// mmdbValue is one distinct data record.
+type mmdbValue struct {
+	iso string
+	reg string // registered_country when it differs from iso
+	src CodeSource
+}
+
+// mmdbData deduplicates data records across networks.
+type mmdbData struct {
+	s      *Store
+	values []mmdbValue
+	index  map[mmdbValue]int
+	names  map[string]string
+}
+
+func newMMDBData(s *Store) *mmdbData {
+	d := &mmdbData{s: s, index: make(map[mmdbValue]int), names: make(map[string]string)}
+	for id, name := range s.nameByID {
+		if name != "" {
+			d.names[s.isoByID[id]] = name
+		}
+	}
+	return d
+}
+
+func (d *mmdbData) value(ni netInfo) mmdbRecord {
+	v := mmdbValue{iso: d.s.isoByID[ni.id], src: ni.src}
+	if ni.reg != ni.id {
+		v.reg = d.s.isoByID[ni.reg]
+	}
+	if v.src == SourceOverride {
+		v.src = SourceCountry
+	}
+	if v.src == SourceFallback {
+		v.iso = ""
+	}
+
+	i, ok := d.index[v]
+	if !ok {
+		i = len(d.values)
+		d.values = append(d.values, v)
+		d.index[v] = i
+	}
+	return mmdbRecord{kind: recData, val: i}
+}
+
+func (d *mmdbData) encode(e *mmdbEncoder, v mmdbValue) {
+	if v.src == SourceFallback {
+		e.mapHeader(0)
+		return
+	}
+
+	field := "country"
+	switch v.src {
+	case SourceRegisteredCountry:
+		field = "registered_country"
+	case SourceRepresentedCountry:
+		field = "represented_country"
+	}
+
+	if v.reg == "" || field == "registered_country" {
+		e.mapHeader(1)
+	} else {
+		e.mapHeader(2)
+		e.string("registered_country")
+		d.encodeCountry(e, v.reg)
+	}
+	e.string(field)
+	d.encodeCountry(e, v.iso)
+}
+
+func (d *mmdbData) encodeCountry(e *mmdbEncoder, iso string) {
+	name := d.names[iso]
+	if name == "" {
+		e.mapHeader(1)
+	} else {
+		e.mapHeader(2)
+	}
+	e.string("iso_code")
+	e.string(iso)
+	if name != "" {
+		e.string("names")
+		e.mapHeader(1)
+		e.string("en")
+		e.string(name)
+	}
+}
+
+// mmdbEncoder writes values in the MaxMind DB data section format.
+type mmdbEncoder struct {
+	buf []byte
+}
+
+func (e *mmdbEncoder) control(typ, size int) {
+	first := byte(typ << 5)
+	if typ > 7 {
+		first = 0
+	}
+
+	var ext []byte
+	switch {
+	case size < 29:
+		first |= byte(size)
+	case size < 29+256:
+		first |= 29
+		ext = []byte{byte(size - 29)}
+	case size < 285+65536:
+		first |= 30
+		n := size - 285
+		ext = []byte{byte(n >> 8), byte(n)}
+	default:
+		first |= 31
+		n := size - 65821
+		ext = []byte{byte(n >> 16), byte(n >> 8), byte(n)}
+	}
+
+	e.buf = append(e.buf, first)
+	if typ > 7 {
+		e.buf = append(e.buf, byte(typ-7))
+	}
+	e.buf = append(e.buf, ext...)
+}
+
+func (e *mmdbEncoder) mapHeader(n int) {
+	e.control(mmdbMap, n)
+}
+
+func (e *mmdbEncoder) arrayHeader(n int) {
+	e.control(mmdbArray, n)
+}
+
+func (e *mmdbEncoder) string(s string) {
+	e.control(mmdbString, len(s))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *mmdbEncoder) uint(typ int, v uint64) {
+	n := 0
+	for x := v; x > 0; x >>= 8 {
+		n++
+	}
+	e.control(typ, n)
+	for i := n - 1; i >= 0; i-- {
+		e.buf = append(e.buf, byte(v>>(8*i)))
+	}
+}
@@ -0,0 +1,177 @@
+package geoip
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+type testNetwork struct {
+	cidr string
+	iso  string
+	src  CodeSource
+}
+
+func buildTestStore(t *testing.T, nets []testNetwork, overrides []Override) *Store {
+	t.Helper()
+
+	opt := DefaultOptions()
+	opt.Overrides = overrides
+	b := newBuilder(opt, "test", len(nets), len(nets))
+	for _, n := range nets {
+		b.addNetwork(netip.MustParsePrefix(n.cidr), n.iso, "", n.src)
+	}
+	b.setName("DE", "Germany")
+	return b.finish(DatasetInfo{Type: "test"})
+}
+
+func writeTestMMDB(t *testing.T, s *Store, opt MMDBOptions) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := s.WriteMMDB(&buf, opt); err != nil {
+		t.Fatalf("WriteMMDB: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "out.mmdb")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// storeNetworks lists every network of s with its code and source.
+func storeNetworks(s *Store) map[netip.Prefix]testNetwork {
+	out := make(map[netip.Prefix]testNetwork)
+	s.forEach(func(p netip.Prefix, ni netInfo) {
+		src := ni.src
+		if src == SourceOverride {
+			src = SourceCountry
+		}
+		out[p] = testNetwork{cidr: p.String(), iso: s.isoByID[ni.id], src: src}
+	})
+	return out
+}
+
+func TestWriteMMDBRoundTrip(t *testing.T) {
+	nets := []testNetwork{
+		{"0.0.0.0/8", "ZZ", SourceFallback},
+		{"1.0.0.0/24", "AU", SourceCountry},
+		{"1.0.1.0/24", "CN", SourceRegisteredCountry},
+		{"2.16.0.0/13", "DE", SourceCountry},
+		{"5.0.0.0/8", "DE", SourceCountry},
+		{"81.2.69.142/32", "GB", SourceCountry},
+		{"128.0.0.0/1", "US", SourceCountry},
+		{"2001:db8::/32", "NL", SourceCountry},
+		{"2a00::/12", "FR", SourceRepresentedCountry},
+		{"2c0f:f000::/20", "ZA", SourceCountry},
+		{"fc00::/7", "ZZ", SourceFallback},
+	}
+	overrides := []Override{
+		{Prefix: netip.MustParsePrefix("5.5.5.0/24"), ISO: "FR"},
+		{Prefix: netip.MustParsePrefix("2a00:1450::/32"), ISO: "US"},
+	}
+
+	want := buildTestStore(t, nets, overrides)
+	path := writeTestMMDB(t, want, DefaultMMDBOptions())
+
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	if err := db.Verify(); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if db.Metadata.DatabaseType != "GeoIP2-Country" || db.Metadata.IPVersion != 6 {
+		t.Fatalf("metadata = %+v", db.Metadata)
+	}
+
+	got, err := Load(context.Background(), path, DefaultOptions())
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	wantNets, gotNets := storeNetworks(want), storeNetworks(got)
+	for p, w := range wantNets {
+		g, ok := gotNets[p]
+		if !ok {
+			t.Errorf("network %s missing after round trip", p)
+			continue
+		}
+		if g != w {
+			t.Errorf("network %s = %+v, want %+v", p, g, w)
+		}
+	}
+	for p := range gotNets {
+		if _, ok := wantNets[p]; !ok {
+			t.Errorf("unexpected network %s after round trip", p)
+		}
+	}
+
+	for _, iso := range want.CountryCodes() {
+		wr, _ := want.RangesByCountry(iso)
+		gr, _ := got.RangesByCountry(iso)
+		if w, g := wr.Prefixes(), gr.Prefixes(); !slices.Equal(w, g) {
+			t.Errorf("RangesByCountry(%s) = %v, want %v", iso, g, w)
+		}
+	}
+}
+
+func TestWriteMMDBRecords(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"5.0.0.0/8", "DE", SourceCountry},
+		{"1.0.1.0/24", "CN", SourceRegisteredCountry},
+	}, nil)
+	path := writeTestMMDB(t, s, MMDBOptions{
+		Description: "round trip",
+		BuildTime:   time.Unix(1700000000, 0),
+		IPv4Aliases: true,
+	})
+
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+
+	if db.Metadata.BuildEpoch != 1700000000 || db.Metadata.Description["en"] != "round trip" {
+		t.Errorf("metadata = %+v", db.Metadata)
+	}
+	if !slices.Equal(db.Metadata.Languages, []string{"en"}) {
+		t.Errorf("languages = %v", db.Metadata.Languages)
+	}
+
+	var rec struct {
+		Country struct {
+			ISOCode string            `maxminddb:"iso_code"`
+			Names   map[string]string `maxminddb:"names"`
+		} `maxminddb:"country"`
+		RegisteredCountry CountryInfo `maxminddb:"registered_country"`
+	}
+
+	// IPv4, IPv4-mapped, 6to4 and Teredo forms of the same address.
+	for _, ip := range []string{"5.6.7.8", "::ffff:5.6.7.8", "2002:506:708::1", "2001:0:506:708::1"} {
+		rec.Country.ISOCode, rec.Country.Names = "", nil
+		if err := db.Lookup(net.ParseIP(ip), &rec); err != nil {
+			t.Fatalf("lookup %s: %v", ip, err)
+		}
+		if rec.Country.ISOCode != "DE" || rec.Country.Names["en"] != "Germany" {
+			t.Errorf("lookup %s = %+v, want DE/Germany", ip, rec.Country)
+		}
+	}
+
+	if err := db.Lookup(net.ParseIP("1.0.1.1"), &rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.RegisteredCountry.ISOCode != "CN" {
+		t.Errorf("registered_country = %q, want CN", rec.RegisteredCountry.ISOCode)
+	}
+}
@@ -0,0 +1,143 @@
+package geoip
+
+import (
+	"math/big"
+	"net/netip"
+	"slices"
+)
+
+// NetSet is a set of addresses, kept per family as sorted ranges that
+// neither overlap nor touch. The zero value is the empty set.
+type NetSet struct {
+	v4, v6 []AddrRange
+}
+
+// NewNetSet returns the set of addresses covered by prefixes.
+func NewNetSet(prefixes ...netip.Prefix) NetSet {
+	var v4, v6 []AddrRange
+	for _, p := range prefixes {
+		p = p.Masked()
+		r := AddrRange{First: p.Addr(), Last: lastAddr(p)}
+		if p.Addr().Is4() {
+			v4 = append(v4, r)
+		} else {
+			v6 = append(v6, r)
+		}
+	}
+	return NetSet{v4: mergeRanges(v4), v6: mergeRanges(v6)}
+}
+
+func (a NetSet) IsEmpty() bool {
+	return len(a.v4) == 0 && len(a.v6) == 0
+}
+
+func (a NetSet) Union(b NetSet) NetSet {
+	return NetSet{
+		v4: mergeRanges(append(slices.Clip(a.v4), b.v4...)),
+		v6: mergeRanges(append(slices.Clip(a.v6), b.v6...)),
+	}
+}
+
+func (a NetSet) Intersect(b NetSet) NetSet {
+	return NetSet{v4: intersectRanges(a.v4, b.v4), v6: intersectRanges(a.v6, b.v6)}
+}
+
+// Subtract returns the addresses of a that are not in b.
+func (a NetSet) Subtract(b NetSet) NetSet {
+	return NetSet{v4: subtractRanges(a.v4, b.v4), v6: subtractRanges(a.v6, b.v6)}
+}
+
+// Prefixes returns the minimal list of prefixes covering the set, IPv4
+// first, in address order.
+func (a NetSet) Prefixes() []netip.Prefix {
+	var out []netip.Prefix
+	for _, r := range a.v4 {
+		out = append(out, r.Prefixes()...)
+	}
+	for _, r := range a.v6 {
+		out = append(out, r.Prefixes()...)
+	}
+	return out
+}
+
+// Addresses returns the number of IPv4 and IPv6 addresses in the set.
+func (a NetSet) Addresses() (v4, v6 *big.Int) {
+	v4, v6 = new(big.Int), new(big.Int)
+	for _, r := range a.v4 {
+		v4.Add(v4, r.Size())
+	}
+	for _, r := range a.v6 {
+		v6.Add(v6, r.Size())
+	}
+	return v4, v6
+}
+
+// mergeRanges sorts rs and joins ranges that overlap or touch.
+func mergeRanges(rs []AddrRange) []AddrRange {
+	if len(rs) == 0 {
+		return nil
+	}
+	slices.SortFunc(rs, func(a, b AddrRange) int { return a.First.Compare(b.First) })
+
+	out := rs[:1]
+	for _, r := range rs[1:] {
+		cur := &out[len(out)-1]
+		// cur.Last.Next() is invalid at the end of the address space, and
+		// nothing can follow it then.
+		if next := cur.Last.Next(); !next.IsValid() || !next.Less(r.First) {
+			if cur.Last.Less(r.Last) {
+				cur.Last = r.Last
+			}
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+func intersectRanges(a, b []AddrRange) []AddrRange {
+	var out []AddrRange
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		first, last := a[i].First, a[i].Last
+		if first.Less(b[j].First) {
+			first = b[j].First
+		}
+		if b[j].Last.Less(last) {
+			last = b[j].Last
+		}
+		if !last.Less(first) {
+			out = append(out, AddrRange{first, last})
+		}
+		if a[i].Last.Less(b[j].Last) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+func subtractRanges(a, b []AddrRange) []AddrRange {
+	var out []AddrRange
+	j := 0
+	for _, r := range a {
+		for j < len(b) && b[j].Last.Less(r.First) {
+			j++
+		}
+		first := r.First
+		for k := j; k < len(b) && !r.Last.Less(b[k].First); k++ {
+			if first.Less(b[k].First) {
+				out = append(out, AddrRange{first, b[k].First.Prev()})
+			}
+			if !b[k].Last.Less(r.Last) {
+				first = netip.Addr{} // rest of r is removed
+				break
+			}
+			first = b[k].Last.Next()
+		}
+		if first.IsValid() {
+			out = append(out, AddrRange{first, r.Last})
+		}
+	}
+	return out
+}
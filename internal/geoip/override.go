@@ -0,0 +1,356 @@
+package geoip
+
+import (
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/countries"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Override forces a country code for a network, on top of the database.
+type Override struct {
+	Prefix  netip.Prefix
+	ISO     string
+	Comment string
+	Expires time.Time // zero: never expires
+	Origin  string    // "file:line" the override was read from
+}
+
+func (o Override) Expired(now time.Time) bool {
+	return !o.Expires.IsZero() && !now.Before(o.Expires)
+}
+
+// ReadOverrideFiles reads override files in order. CSV files have the columns
+// cidr,iso_code[,comment[,expires]]; YAML files hold an "overrides" list of
+// {cidr, code, comment, expires} objects. Expiry is a date (2006-01-02, end of
+// that day is exclusive) or an RFC 3339 timestamp.
+func ReadOverrideFiles(paths []string) ([]Override, error) {
+	var out []Override
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+
+		var (
+			items []Override
+			err   error
+		)
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml":
+			items, err = readOverridesYAML(path)
+		default:
+			items, err = readOverridesCSV(path)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read overrides %s: %w", path, err)
+		}
+		out = append(out, items...)
+	}
+	return out, nil
+}
+
+func readOverridesCSV(path string) ([]Override, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	var out []Override
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		origin := fmt.Sprintf("%s:%d", path, line)
+
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("%s: expected at least cidr,iso_code", origin)
+		}
+		// Optional header row.
+		if len(out) == 0 && strings.EqualFold(strings.TrimSpace(rec[0]), "cidr") {
+			continue
+		}
+
+		var comment, expires string
+		if len(rec) > 2 {
+			comment = rec[2]
+		}
+		if len(rec) > 3 {
+			expires = rec[3]
+		}
+
+		o, err := parseOverride(rec[0], rec[1], comment, expires, origin)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+type overrideFileYAML struct {
+	Overrides []struct {
+		CIDR    string `yaml:"cidr"`
+		Code    string `yaml:"code"`
+		Comment string `yaml:"comment"`
+		Expires string `yaml:"expires"`
+	} `yaml:"overrides"`
+}
+
+func readOverridesYAML(path string) ([]Override, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc overrideFileYAML
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	out := make([]Override, 0, len(doc.Overrides))
+	for i, it := range doc.Overrides {
+		origin := fmt.Sprintf("%s:overrides[%d]", path, i)
+		o, err := parseOverride(it.CIDR, it.Code, it.Comment, it.Expires, origin)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+func parseOverride(cidr, iso, comment, expires, origin string) (Override, error) {
+	pfx, err := parseNetwork(cidr)
+	if err != nil {
+		return Override{}, fmt.Errorf("%s: %w", origin, err)
+	}
+
+	iso = countries.Alpha2(iso)
+	if !isISOCode(iso) {
+		return Override{}, fmt.Errorf("%s: invalid iso code %q", origin, iso)
+	}
+
+	o := Override{
+		Prefix:  pfx,
+		ISO:     iso,
+		Comment: strings.TrimSpace(comment),
+		Origin:  origin,
+	}
+
+	if expires = strings.TrimSpace(expires); expires != "" {
+		if t, err := time.Parse(time.DateOnly, expires); err == nil {
+			o.Expires = t.AddDate(0, 0, 1)
+		} else if t, err := time.Parse(time.RFC3339, expires); err == nil {
+			o.Expires = t
+		} else {
+			return Override{}, fmt.Errorf("%s: invalid expires %q", origin, expires)
+		}
+	}
+
+	return o, nil
+}
+
+// parseNetwork parses a CIDR (or a bare address as a host route) and folds
+// IPv4-mapped IPv6 prefixes into plain IPv4.
+func parseNetwork(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid cidr %q", s)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q", s)
+	}
+	if p.Addr().Is4In6() && p.Bits() >= 96 {
+		p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+	}
+	return p.Masked(), nil
+}
+
+func isISOCode(s string) bool {
+	if len(s) != 2 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// overrideSet holds the active overrides, one per prefix (the last one read
+// wins), sorted by address.
+type overrideSet struct {
+	items []Override
+	// bits holds the distinct prefix lengths of the IPv4 and the IPv6
+	// overrides, longest first.
+	bits  [2][]int
+	cover *coverSet
+}
+
+func newOverrideSet(list []Override, now time.Time) *overrideSet {
+	byPrefix := make(map[netip.Prefix]Override, len(list))
+	for _, o := range list {
+		if o.Expired(now) {
+			continue
+		}
+		byPrefix[o.Prefix] = o
+	}
+
+	set := &overrideSet{items: make([]Override, 0, len(byPrefix))}
+	prefixes := make([]netip.Prefix, 0, len(byPrefix))
+	for p, o := range byPrefix {
+		set.items = append(set.items, o)
+		prefixes = append(prefixes, p)
+	}
+	sort.Slice(set.items, func(i, j int) bool { return prefixLess(set.items[i].Prefix, set.items[j].Prefix) })
+	set.bits = prefixLengths(prefixes)
+	set.cover = newCoverSet(prefixes)
+	return set
+}
+
+func family(addr netip.Addr) int {
+	if addr.Is4() {
+		return 0
+	}
+	return 1
+}
+
+// prefixLengths returns the distinct lengths of the IPv4 and the IPv6
+// prefixes, longest first.
+func prefixLengths(prefixes []netip.Prefix) [2][]int {
+	var (
+		out  [2][]int
+		seen [2][129]bool
+	)
+	for _, p := range prefixes {
+		if f := family(p.Addr()); !seen[f][p.Bits()] {
+			seen[f][p.Bits()] = true
+			out[f] = append(out[f], p.Bits())
+		}
+	}
+	for _, bits := range out {
+		sort.Sort(sort.Reverse(sort.IntSlice(bits)))
+	}
+	return out
+}
+
+// match returns the most specific override containing addr that has not
+// expired by now. It looks addr up once per override prefix length.
+func (set *overrideSet) match(addr netip.Addr, now time.Time) (Override, bool) {
+	for _, bits := range set.bits[family(addr)] {
+		p := netip.PrefixFrom(addr, bits).Masked()
+		i := sort.Search(len(set.items), func(i int) bool { return !prefixLess(set.items[i].Prefix, p) })
+		if i < len(set.items) && set.items[i].Prefix == p && !set.items[i].Expired(now) {
+			return set.items[i], true
+		}
+	}
+	return Override{}, false
+}
+
+// nextExpiry returns when the first of the overrides expires.
+func (set *overrideSet) nextExpiry() (time.Time, bool) {
+	var next time.Time
+	for _, o := range set.items {
+		if !o.Expires.IsZero() && (next.IsZero() || o.Expires.Before(next)) {
+			next = o.Expires
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// pieces returns the address space owned by each override: its prefix minus
+// any more specific override inside it.
+func (set *overrideSet) pieces(fn func(p netip.Prefix, o Override)) {
+	for _, o := range set.items {
+		var inner []netip.Prefix
+		for _, x := range set.items {
+			if x.Prefix.Bits() > o.Prefix.Bits() && o.Prefix.Contains(x.Prefix.Addr()) {
+				inner = append(inner, x.Prefix)
+			}
+		}
+		for _, p := range newCoverSet(inner).subtract(o.Prefix) {
+			fn(p, o)
+		}
+	}
+}
+
+// shadowSet holds the database networks that overrides carved pieces out
+// of, so lookups can still tell what the data said under an override.
+type shadowSet struct {
+	nets []shadowNet // sorted by address, then length
+	bits [2][]int    // distinct prefix lengths, as in overrideSet
+}
+
+type shadowNet struct {
+	prefix netip.Prefix
+	info   netInfo
+}
+
+// newShadowSet sorts nets and keeps the first one of each prefix, as the
+// store does with its networks.
+func newShadowSet(nets []shadowNet) *shadowSet {
+	slices.SortStableFunc(nets, func(a, b shadowNet) int {
+		if c := a.prefix.Addr().Compare(b.prefix.Addr()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.prefix.Bits(), b.prefix.Bits())
+	})
+	nets = slices.CompactFunc(nets, func(a, b shadowNet) bool { return a.prefix == b.prefix })
+
+	prefixes := make([]netip.Prefix, len(nets))
+	for i, n := range nets {
+		prefixes[i] = n.prefix
+	}
+	return &shadowSet{nets: nets, bits: prefixLengths(prefixes)}
+}
+
+// find returns the network with prefix p.
+func (set *shadowSet) find(p netip.Prefix) (shadowNet, bool) {
+	i := sort.Search(len(set.nets), func(i int) bool { return !prefixLess(set.nets[i].prefix, p) })
+	if i < len(set.nets) && set.nets[i].prefix == p {
+		return set.nets[i], true
+	}
+	return shadowNet{}, false
+}
+
+// match returns the most specific network containing addr.
+func (set *shadowSet) match(addr netip.Addr) (shadowNet, bool) {
+	for _, bits := range set.bits[family(addr)] {
+		if n, ok := set.find(netip.PrefixFrom(addr, bits).Masked()); ok {
+			return n, true
+		}
+	}
+	return shadowNet{}, false
+}
@@ -0,0 +1,134 @@
+package geoip
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestOverrideSetMatch(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	set := newOverrideSet([]Override{
+		{Prefix: netip.MustParsePrefix("10.0.0.0/8"), ISO: "DE"},
+		{Prefix: netip.MustParsePrefix("10.1.0.0/16"), ISO: "FR", Expires: now.Add(time.Hour)},
+		{Prefix: netip.MustParsePrefix("10.1.2.0/24"), ISO: "NL", Expires: now.Add(2 * time.Hour)},
+		{Prefix: netip.MustParsePrefix("10.9.9.9/32"), ISO: "PL"},
+		{Prefix: netip.MustParsePrefix("2001:db8::/32"), ISO: "US"},
+		{Prefix: netip.MustParsePrefix("2001:db8:1::/48"), ISO: "CA"},
+		// Already expired when the set is built.
+		{Prefix: netip.MustParsePrefix("192.0.2.0/24"), ISO: "IT", Expires: now},
+	}, now)
+
+	tests := []struct {
+		addr string
+		at   time.Duration
+		want string // "" for no match
+	}{
+		{"10.2.3.4", 0, "DE"},
+		{"10.1.9.9", 0, "FR"},
+		{"10.1.2.3", 0, "NL"},
+		{"10.9.9.9", 0, "PL"},
+		{"10.9.9.8", 0, "DE"},
+		{"11.0.0.1", 0, ""},
+		{"2001:db8:1::1", 0, "CA"},
+		{"2001:db8:2::1", 0, "US"},
+		{"2001:db9::1", 0, ""},
+		{"192.0.2.1", 0, ""},
+		// The /16 expired: its addresses fall back to the /8, the /24
+		// inside it still holds.
+		{"10.1.9.9", time.Hour, "DE"},
+		{"10.1.2.3", time.Hour, "NL"},
+		{"10.1.2.3", 2 * time.Hour, "DE"},
+	}
+	for _, tt := range tests {
+		o, ok := set.match(netip.MustParseAddr(tt.addr), now.Add(tt.at))
+		got := ""
+		if ok {
+			got = o.ISO
+		}
+		if got != tt.want {
+			t.Errorf("match(%s) after %s = %q, want %q", tt.addr, tt.at, got, tt.want)
+		}
+	}
+
+	if at, ok := set.nextExpiry(); !ok || !at.Equal(now.Add(time.Hour)) {
+		t.Errorf("nextExpiry() = %s, %v, want %s", at, ok, now.Add(time.Hour))
+	}
+	if _, ok := newOverrideSet(nil, now).nextExpiry(); ok {
+		t.Error("nextExpiry() of an empty set reported an expiry")
+	}
+}
+
+func TestStoreOverrideForSkipsExpired(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"1.2.3.0/24", "DE", SourceCountry},
+	}, []Override{{Prefix: netip.MustParsePrefix("1.2.3.4/32"), ISO: "FR", Expires: time.Now().Add(time.Hour)}})
+
+	if o, ok := s.OverrideFor(netip.MustParseAddr("1.2.3.4")); !ok || o.ISO != "FR" {
+		t.Fatalf("OverrideFor(1.2.3.4) = %+v, %v, want FR", o, ok)
+	}
+	if _, ok := s.NextExpiry(); !ok {
+		t.Error("NextExpiry() reported no expiry")
+	}
+
+	// Expire the override behind the store's back, as time would.
+	s.overrides.items[0].Expires = time.Now().Add(-time.Second)
+	if o, ok := s.OverrideFor(netip.MustParseAddr("1.2.3.4")); ok {
+		t.Errorf("OverrideFor(1.2.3.4) = %+v after expiry, want none", o)
+	}
+}
+
+func TestExplainUnderOverride(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"10.0.0.0/8", "DE", SourceCountry},
+		{"10.1.0.0/16", "FR", SourceRegisteredCountry},
+		{"11.1.0.0/16", "US", SourceCountry},
+	}, []Override{
+		{Prefix: netip.MustParsePrefix("10.1.2.0/24"), ISO: "PL"},
+		{Prefix: netip.MustParsePrefix("11.0.0.0/8"), ISO: "IT"},
+	})
+	r := NewResolver(nil, s)
+
+	tests := []struct {
+		addr    string
+		network string // database network, "" if none
+		dbISO   string
+		iso     string
+	}{
+		{"10.1.2.3", "10.1.0.0/16", "FR", "PL"},
+		// Pieces of the /8 and the /16 around the override coincide; the
+		// /16 keeps them.
+		{"10.1.3.1", "10.1.3.0/24", "FR", "FR"},
+		{"10.2.0.1", "10.2.0.0/15", "DE", "DE"},
+		// The override swallows the whole source network.
+		{"11.1.2.3", "11.1.0.0/16", "US", "IT"},
+		{"11.2.0.1", "", UnknownISO, "IT"},
+	}
+	for _, tt := range tests {
+		tr, err := r.Explain(netip.MustParseAddr(tt.addr))
+		if err != nil {
+			t.Fatalf("Explain(%s): %v", tt.addr, err)
+		}
+		network := ""
+		if tr.DatabaseNetwork.IsValid() {
+			network = tr.DatabaseNetwork.String()
+		}
+		if network != tt.network || tr.DatabaseISO != tt.dbISO || tr.ISO != tt.iso {
+			t.Errorf("Explain(%s) = database %q %s, code %s; want %q %s, %s",
+				tt.addr, network, tr.DatabaseISO, tr.ISO, tt.network, tt.dbISO, tt.iso)
+		}
+	}
+
+	// Once the override expires, lookups answer what the data said.
+	for i := range s.overrides.items {
+		s.overrides.items[i].Expires = time.Now().Add(-time.Second)
+	}
+	m, err := r.Lookup(netip.MustParseAddr("10.1.2.3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ISO != "FR" || m.Source != SourceRegisteredCountry || m.Network.String() != "10.1.0.0/16" || m.Provenance != "test" {
+		t.Errorf("Lookup(10.1.2.3) after expiry = %s %s %s from %q, want FR registered_country 10.1.0.0/16 from test",
+			m.ISO, m.Source, m.Network, m.Provenance)
+	}
+}
@@ -0,0 +1,139 @@
+package geoip
+
+import (
+	"cmp"
+	"encoding/binary"
+	"iter"
+	"net/netip"
+)
+
+// v4Net is a packed IPv4 network.
+type v4Net struct {
+	addr uint32
+	bits uint8
+	info netInfo
+}
+
+// v6Net is a packed IPv6 network; hi and lo are the address halves.
+type v6Net struct {
+	hi, lo uint64
+	bits   uint8
+	info   netInfo
+}
+
+func packV4(a netip.Addr) uint32 {
+	b := a.As4()
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func packV6(a netip.Addr) (hi, lo uint64) {
+	b := a.As16()
+	return binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])
+}
+
+func (n v4Net) prefix() netip.Prefix {
+	var a [4]byte
+	binary.BigEndian.PutUint32(a[:], n.addr)
+	return netip.PrefixFrom(netip.AddrFrom4(a), int(n.bits))
+}
+
+func (n v6Net) prefix() netip.Prefix {
+	var a [16]byte
+	binary.BigEndian.PutUint64(a[:8], n.hi)
+	binary.BigEndian.PutUint64(a[8:], n.lo)
+	return netip.PrefixFrom(netip.AddrFrom16(a), int(n.bits))
+}
+
+// compare orders networks by address, then by length (shorter first).
+func (n v4Net) compare(addr uint32, bits uint8) int {
+	if c := cmp.Compare(n.addr, addr); c != 0 {
+		return c
+	}
+	return cmp.Compare(n.bits, bits)
+}
+
+func (n v6Net) compare(hi, lo uint64, bits uint8) int {
+	if c := cmp.Compare(n.hi, hi); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(n.lo, lo); c != 0 {
+		return c
+	}
+	return cmp.Compare(n.bits, bits)
+}
+
+func (n v4Net) contains(addr uint32) bool {
+	mask := ^uint32(0) << (32 - n.bits)
+	return addr&mask == n.addr
+}
+
+func (n v6Net) contains(hi, lo uint64) bool {
+	if n.bits <= 64 {
+		mask := ^uint64(0) << (64 - n.bits)
+		return hi&mask == n.hi
+	}
+	mask := ^uint64(0) << (128 - n.bits)
+	return hi == n.hi && lo&mask == n.lo
+}
+
+// Ranges is a read-only view of one country's networks, in address order
+// (IPv4 first). It points into the store and copies nothing.
+type Ranges struct {
+	s   *Store
+	idx []uint32
+}
+
+func (r Ranges) Len() int {
+	return len(r.idx)
+}
+
+// At returns the i-th network of the view.
+func (r Ranges) At(i int) netip.Prefix {
+	p, _ := r.s.netAt(r.idx[i])
+	return p
+}
+
+// Slice returns the view of networks [from, to).
+func (r Ranges) Slice(from, to int) Ranges {
+	return Ranges{s: r.s, idx: r.idx[from:to]}
+}
+
+// All iterates the networks of the view in order.
+func (r Ranges) All() iter.Seq2[int, netip.Prefix] {
+	return func(yield func(int, netip.Prefix) bool) {
+		for i := range r.idx {
+			if !yield(i, r.At(i)) {
+				return
+			}
+		}
+	}
+}
+
+// JoinRanges concatenates views of one store. A single view is returned
+// as is; otherwise only the indices are copied.
+func JoinRanges(rs ...Ranges) Ranges {
+	switch len(rs) {
+	case 0:
+		return Ranges{}
+	case 1:
+		return rs[0]
+	}
+	n := 0
+	for _, r := range rs {
+		n += len(r.idx)
+	}
+	idx := make([]uint32, 0, n)
+	for _, r := range rs {
+		idx = append(idx, r.idx...)
+	}
+	return Ranges{s: rs[0].s, idx: idx}
+}
+
+// Prefixes copies the networks of the view into a new slice.
+func (r Ranges) Prefixes() []netip.Prefix {
+	out := make([]netip.Prefix, len(r.idx))
+	for i := range r.idx {
+		out[i] = r.At(i)
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+package geoip
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// halves splits p into its two child prefixes. p must be masked and shorter
+// than the address length.
+func halves(p netip.Prefix) (netip.Prefix, netip.Prefix) {
+	bits := p.Bits() + 1
+	lo := netip.PrefixFrom(p.Addr(), bits)
+
+	if p.Addr().Is4() {
+		a := p.Addr().As4()
+		a[(bits-1)/8] |= 0x80 >> ((bits - 1) % 8)
+		return lo, netip.PrefixFrom(netip.AddrFrom4(a), bits)
+	}
+	a := p.Addr().As16()
+	a[(bits-1)/8] |= 0x80 >> ((bits - 1) % 8)
+	return lo, netip.PrefixFrom(netip.AddrFrom16(a), bits)
+}
+
+// lastAddr returns the highest address in p.
+func lastAddr(p netip.Prefix) netip.Addr {
+	p = p.Masked()
+	if p.Addr().Is4() {
+		a := p.Addr().As4()
+		setHostBits(a[:], p.Bits())
+		return netip.AddrFrom4(a)
+	}
+	a := p.Addr().As16()
+	setHostBits(a[:], p.Bits())
+	return netip.AddrFrom16(a)
+}
+
+func setHostBits(b []byte, bits int) {
+	for i := range b {
+		switch {
+		case bits >= 8:
+			bits -= 8
+		case bits > 0:
+			b[i] |= 0xff >> bits
+			bits = 0
+		default:
+			b[i] = 0xff
+		}
+	}
+}
+
+// rangeToPrefixes converts the inclusive range [first, last] (same family)
+// into the minimal list of prefixes covering it.
+func rangeToPrefixes(first, last netip.Addr) []netip.Prefix {
+	var out []netip.Prefix
+	for !last.Less(first) {
+		bits := first.BitLen()
+		for bits > 0 {
+			wider := netip.PrefixFrom(first, bits-1)
+			if wider.Masked().Addr() != first || last.Less(lastAddr(wider)) {
+				break
+			}
+			bits--
+		}
+
+		p := netip.PrefixFrom(first, bits)
+		out = append(out, p)
+
+		end := lastAddr(p)
+		if end == last {
+			break
+		}
+		first = end.Next()
+	}
+	return out
+}
+
+// prefixLess orders prefixes by address, then by length (shorter first).
+func prefixLess(a, b netip.Prefix) bool {
+	if a.Addr() != b.Addr() {
+		return a.Addr().Less(b.Addr())
+	}
+	return a.Bits() < b.Bits()
+}
+
+// coverSet is a sorted set of non-overlapping prefixes used to carve
+// already-claimed address space out of other prefixes.
+type coverSet struct {
+	v4 []netip.Prefix
+	v6 []netip.Prefix
+}
+
+func newCoverSet(prefixes []netip.Prefix) *coverSet {
+	cs := &coverSet{}
+	for _, p := range prefixes {
+		if p.Addr().Is4() {
+			cs.v4 = append(cs.v4, p)
+		} else {
+			cs.v6 = append(cs.v6, p)
+		}
+	}
+	cs.v4 = dropCovered(cs.v4)
+	cs.v6 = dropCovered(cs.v6)
+	return cs
+}
+
+// dropCovered sorts ps and removes prefixes contained in another prefix.
+func dropCovered(ps []netip.Prefix) []netip.Prefix {
+	sort.Slice(ps, func(i, j int) bool { return prefixLess(ps[i], ps[j]) })
+
+	out := ps[:0]
+	for _, p := range ps {
+		if n := len(out); n > 0 && out[n-1].Overlaps(p) {
+			// Sorted by address then length: the previous one is a supernet.
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func (cs *coverSet) family(p netip.Prefix) []netip.Prefix {
+	if p.Addr().Is4() {
+		return cs.v4
+	}
+	return cs.v6
+}
+
+func (cs *coverSet) empty() bool {
+	return cs == nil || len(cs.v4)+len(cs.v6) == 0
+}
+
+// state reports whether p is fully covered, and whether it overlaps the set
+// at all.
+func (cs *coverSet) state(p netip.Prefix) (covered, overlapping bool) {
+	set := cs.family(p)
+	if len(set) == 0 {
+		return false, false
+	}
+
+	// First entry starting at or after p.
+	i := sort.Search(len(set), func(i int) bool {
+		return !set[i].Addr().Less(p.Addr())
+	})
+
+	// An entry starting before (or at) p may contain it.
+	if i < len(set) && set[i].Addr() == p.Addr() && set[i].Bits() <= p.Bits() {
+		return true, true
+	}
+	if i > 0 && set[i-1].Contains(p.Addr()) {
+		return true, true
+	}
+
+	// Otherwise only entries starting inside p can overlap it.
+	if i < len(set) && p.Contains(set[i].Addr()) {
+		return false, true
+	}
+	return false, false
+}
+
+// subtract returns the parts of p not covered by the set, as a minimal list
+// of prefixes in address order.
+func (cs *coverSet) subtract(p netip.Prefix) []netip.Prefix {
+	if cs.empty() {
+		return []netip.Prefix{p}
+	}
+	return cs.subtractInto(nil, p)
+}
+
+func (cs *coverSet) subtractInto(out []netip.Prefix, p netip.Prefix) []netip.Prefix {
+	covered, overlapping := cs.state(p)
+	switch {
+	case covered:
+		return out
+	case !overlapping:
+		return append(out, p)
+	}
+
+	lo, hi := halves(p)
+	out = cs.subtractInto(out, lo)
+	return cs.subtractInto(out, hi)
+}
+
+// list returns the prefixes of the set, IPv4 first.
+func (cs *coverSet) list() []netip.Prefix {
+	out := make([]netip.Prefix, 0, len(cs.v4)+len(cs.v6))
+	return append(append(out, cs.v4...), cs.v6...)
+}
@@ -0,0 +1,241 @@
+package geoip
+
+import (
+	"cmp"
+	"math"
+	"net/netip"
+	"slices"
+)
+
+// CountryCount is the size of a country in one build, as compared between
+// builds by the quality report.
+type CountryCount struct {
+	Networks      int
+	IPv4Addresses uint64
+	IPv6Slash64s  uint64 // addresses in /64s, rounded down
+}
+
+// countryCounts returns the counts of every country with networks.
+func (s *Store) countryCounts() map[string]CountryCount {
+	out := make(map[string]CountryCount, len(s.isoByID))
+	for id, iso := range s.isoByID {
+		n := int(s.countryStart[id+1] - s.countryStart[id])
+		if n == 0 {
+			continue
+		}
+		out[iso] = CountryCount{Networks: n, IPv4Addresses: s.space[id].v4, IPv6Slash64s: s.space[id].v6.hi}
+	}
+	return out
+}
+
+// SetPrevious records prev as the build s replaces, so the quality report
+// can compare them. Only the counts of prev are kept, and they are written
+// to snapshots of s.
+func (s *Store) SetPrevious(prev *Store) {
+	s.previous = prev.countryCounts()
+}
+
+// QualityOptions tunes QualityReport. Zero fields take the defaults.
+type QualityOptions struct {
+	Limit int // entries kept per list, default 100
+
+	// A country is reported as changed when its network, IPv4 or /64
+	// count moved by at least ChangeThreshold percent, default 20. Countries
+	// with fewer than MinNetworks networks in both builds are not compared,
+	// default 10.
+	ChangeThreshold float64
+	MinNetworks     int
+}
+
+// NetworkIssue is a network the report flags.
+type NetworkIssue struct {
+	Prefix netip.Prefix
+	ISO    string
+	Other  string // registered country, for registered-differs issues
+	Layer  string
+}
+
+// AliasConflict is an IPv6 network inside an IPv4 alias range (IPv4-mapped,
+// 6to4, Teredo) whose code differs from the IPv4 network it embeds. Only a
+// database loaded without SkipAliasedNetworks has such networks.
+type AliasConflict struct {
+	Prefix  netip.Prefix
+	ISO     string
+	Alias   AliasKind
+	IPv4    netip.Prefix // the embedded IPv4 network
+	IPv4ISO string       // code of the IPv4 network, or of one inside it
+}
+
+// CountryChange compares a country between the previous build and this one.
+type CountryChange struct {
+	ISO           string
+	Before, After CountryCount
+	// Change is the largest relative move of the three counts, in percent;
+	// a country that is new or gone counts as +100 or -100.
+	Change float64
+}
+
+// QualityReport lists what looks wrong in the loaded data. The totals count
+// everything; the lists are cut at QualityOptions.Limit.
+type QualityReport struct {
+	RegisteredDiffers      []NetworkIssue
+	TotalRegisteredDiffers int
+	Fallbacks              []NetworkIssue
+	TotalFallbacks         int
+	AliasConflicts         []AliasConflict
+	TotalAliasConflicts    int
+
+	// HasPrevious is false when no previous build is known; Changes is
+	// empty then.
+	HasPrevious  bool
+	Changes      []CountryChange // largest change first
+	TotalChanges int
+}
+
+// QualityReport checks the store for networks whose country differs from
+// the registered one, networks left with the fallback code, alias networks
+// disagreeing with their IPv4 counterpart, and countries that grew or
+// shrank sharply since the previous build.
+func (s *Store) QualityReport(opt QualityOptions) QualityReport {
+	if opt.Limit <= 0 {
+		opt.Limit = 100
+	}
+	if opt.ChangeThreshold <= 0 {
+		opt.ChangeThreshold = 20
+	}
+	if opt.MinNetworks <= 0 {
+		opt.MinNetworks = 10
+	}
+
+	var rep QualityReport
+	check := func(p netip.Prefix, ni netInfo) {
+		if ni.reg != ni.id {
+			rep.TotalRegisteredDiffers++
+			if len(rep.RegisteredDiffers) < opt.Limit {
+				rep.RegisteredDiffers = append(rep.RegisteredDiffers, s.issue(p, ni, s.isoByID[ni.reg]))
+			}
+		}
+		if ni.src == SourceFallback {
+			rep.TotalFallbacks++
+			if len(rep.Fallbacks) < opt.Limit {
+				rep.Fallbacks = append(rep.Fallbacks, s.issue(p, ni, ""))
+			}
+		}
+	}
+	for _, n := range s.v4 {
+		check(n.prefix(), n.info)
+	}
+	for _, n := range s.v6 {
+		p := n.prefix()
+		check(p, n.info)
+		if c, ok := s.aliasConflict(p, n.info); ok {
+			rep.TotalAliasConflicts++
+			if len(rep.AliasConflicts) < opt.Limit {
+				rep.AliasConflicts = append(rep.AliasConflicts, c)
+			}
+		}
+	}
+
+	if s.previous != nil {
+		rep.HasPrevious = true
+		rep.Changes = s.countryChanges(opt)
+		rep.TotalChanges = len(rep.Changes)
+		if len(rep.Changes) > opt.Limit {
+			rep.Changes = rep.Changes[:opt.Limit]
+		}
+	}
+	return rep
+}
+
+func (s *Store) issue(p netip.Prefix, ni netInfo, other string) NetworkIssue {
+	return NetworkIssue{Prefix: p, ISO: s.isoByID[ni.id], Other: other, Layer: s.layers[ni.layer].Name}
+}
+
+// aliasConflict checks an IPv6 network that lies inside an alias range and
+// is narrow enough to embed an IPv4 network.
+func (s *Store) aliasConflict(p netip.Prefix, ni netInfo) (AliasConflict, bool) {
+	addr, kind := EmbeddedIPv4(p.Addr())
+	var v4Bits int
+	switch kind {
+	case AliasIPv4Mapped, AliasTeredo:
+		v4Bits = p.Bits() - 96
+	case Alias6to4:
+		v4Bits = min(p.Bits()-16, 32)
+	}
+	if kind == AliasNone || v4Bits <= 0 {
+		return AliasConflict{}, false
+	}
+	// Masking also clears the host bits Teredo's inversion turned to ones.
+	v4 := netip.PrefixFrom(addr, v4Bits).Masked()
+
+	c := AliasConflict{Prefix: p, ISO: s.isoByID[ni.id], Alias: kind, IPv4: v4}
+	got, iso, _, ok := s.Lookup(v4.Addr())
+	if ok && got.Bits() <= v4.Bits() {
+		if iso == c.ISO {
+			return AliasConflict{}, false
+		}
+		c.IPv4ISO = iso
+		return c, true
+	}
+	// Nothing covers the whole embedded network: it conflicts if any IPv4
+	// network inside it has another code.
+	from := packV4(v4.Addr())
+	i, _ := slices.BinarySearchFunc(s.v4, from, func(x v4Net, a uint32) int { return cmp.Compare(x.addr, a) })
+	for ; i < len(s.v4) && v4.Contains(s.v4[i].prefix().Addr()); i++ {
+		if iso := s.isoByID[s.v4[i].info.id]; iso != c.ISO {
+			c.IPv4ISO = iso
+			return c, true
+		}
+	}
+	return AliasConflict{}, false
+}
+
+func (s *Store) countryChanges(opt QualityOptions) []CountryChange {
+	cur := s.countryCounts()
+	var out []CountryChange
+	visit := func(iso string) {
+		before, after := s.previous[iso], cur[iso]
+		if before.Networks < opt.MinNetworks && after.Networks < opt.MinNetworks {
+			return
+		}
+		ch := CountryChange{ISO: iso, Before: before, After: after}
+		for _, pair := range [][2]float64{
+			{float64(before.Networks), float64(after.Networks)},
+			{float64(before.IPv4Addresses), float64(after.IPv4Addresses)},
+			{float64(before.IPv6Slash64s), float64(after.IPv6Slash64s)},
+		} {
+			if d := relChange(pair[0], pair[1]); math.Abs(d) > math.Abs(ch.Change) {
+				ch.Change = d
+			}
+		}
+		if math.Abs(ch.Change) >= opt.ChangeThreshold {
+			out = append(out, ch)
+		}
+	}
+	for iso := range cur {
+		visit(iso)
+	}
+	for iso := range s.previous {
+		if _, ok := cur[iso]; !ok {
+			visit(iso)
+		}
+	}
+	slices.SortFunc(out, func(a, b CountryChange) int {
+		if c := cmp.Compare(math.Abs(b.Change), math.Abs(a.Change)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ISO, b.ISO)
+	})
+	return out
+}
+
+// relChange is the move from a to b in percent of a; from zero it is +100.
+func relChange(a, b float64) float64 {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 100
+	}
+	return (b - a) / a * 100
+}
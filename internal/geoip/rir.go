@@ -0,0 +1,203 @@
+package geoip
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rirAliases maps non-ISO codes used in delegation files to ISO 3166-1.
+var rirAliases = map[string]string{
+	"UK": "GB",
+}
+
+// ExpandPaths expands glob patterns; plain paths are kept as is.
+func ExpandPaths(patterns []string) ([]string, error) {
+	var out []string
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.ContainsAny(p, "*?[") {
+			out = append(out, p)
+			continue
+		}
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return nil, fmt.Errorf("bad pattern %q: %w", p, err)
+		}
+		sort.Strings(matches)
+		out = append(out, matches...)
+	}
+	return out, nil
+}
+
+// LoadRIR builds a Store from RIR delegated / delegated-extended statistics
+// files (ARIN, RIPE NCC, APNIC, LACNIC, AFRINIC). Only allocated and assigned
+// ipv4/ipv6 records are used; the registration country becomes the code of
+// every network (source registered_country). Records without one are
+// fallback networks.
+func LoadRIR(ctx context.Context, paths []string, opt Options) (*Store, error) {
+	if opt.UnknownISO == "" {
+		opt.UnknownISO = UnknownISO
+	}
+
+	files, err := ExpandPaths(paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no rir delegation files")
+	}
+
+	b := newBuilder(opt, LayerRIR, 300000, 150000)
+
+	var (
+		registries []string
+		built      time.Time
+	)
+	for _, path := range files {
+		reg, date, err := readRIRFile(ctx, path, opt.UnknownISO, b)
+		if err != nil {
+			return nil, fmt.Errorf("read rir file %s: %w", path, err)
+		}
+		if reg != "" {
+			registries = append(registries, reg)
+		}
+		if date.After(built) {
+			built = date
+		}
+	}
+
+	return b.finish(DatasetInfo{
+		Type:        "RIR-delegated",
+		BuildTime:   built,
+		Description: strings.Join(registries, ","),
+	}), nil
+}
+
+// readRIRFile adds the records of one file to b and returns the registry name
+// and end date from the version line.
+func readRIRFile(ctx context.Context, path, unknownISO string, b *builder) (string, time.Time, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer f.Close()
+
+	var (
+		registry string
+		date     time.Time
+	)
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		if line%65536 == 0 {
+			if err := ctx.Err(); err != nil {
+				return "", time.Time{}, err
+			}
+		}
+
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "|")
+
+		// Version line: version|registry|serial|records|startdate|enddate|UTCoffset
+		if _, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			if len(fields) > 1 {
+				registry = fields[1]
+			}
+			if len(fields) > 5 {
+				date, _ = time.Parse("20060102", fields[5])
+			}
+			continue
+		}
+
+		// Summary line: registry|*|type|*|count|summary
+		if len(fields) >= 6 && fields[5] == "summary" {
+			continue
+		}
+
+		// Record: registry|cc|type|start|value|date|status[|opaque-id[|extensions]]
+		if len(fields) < 7 {
+			return "", time.Time{}, fmt.Errorf("line %d: expected at least 7 fields", line)
+		}
+		switch strings.ToLower(fields[6]) {
+		case "allocated", "assigned":
+		default:
+			continue
+		}
+
+		cc, src := normalizeISO(fields[1]), SourceRegisteredCountry
+		if alias, ok := rirAliases[cc]; ok {
+			cc = alias
+		}
+		if cc == "" {
+			cc, src = unknownISO, SourceFallback
+		}
+
+		prefixes, err := rirPrefixes(fields[2], fields[3], fields[4])
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("line %d: %w", line, err)
+		}
+		for _, p := range prefixes {
+			b.addNetwork(p, cc, "", src)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return registry, date, nil
+}
+
+// rirPrefixes converts a record's start/value pair to prefixes: IPv4 values
+// are address counts, IPv6 values are prefix lengths. ASN records yield nil.
+func rirPrefixes(typ, start, value string) ([]netip.Prefix, error) {
+	switch typ {
+	case "ipv4":
+		first, err := netip.ParseAddr(start)
+		if err != nil || !first.Is4() {
+			return nil, fmt.Errorf("bad ipv4 start %q", start)
+		}
+		count, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || count == 0 {
+			return nil, fmt.Errorf("bad ipv4 count %q", value)
+		}
+		a := first.As4()
+		end := uint64(a[0])<<24 | uint64(a[1])<<16 | uint64(a[2])<<8 | uint64(a[3])
+		end += count - 1
+		if end > 0xffffffff {
+			return nil, fmt.Errorf("ipv4 range %s+%d overflows", start, count)
+		}
+		last := netip.AddrFrom4([4]byte{byte(end >> 24), byte(end >> 16), byte(end >> 8), byte(end)})
+		return rangeToPrefixes(first, last), nil
+
+	case "ipv6":
+		first, err := netip.ParseAddr(start)
+		if err != nil || !first.Is6() {
+			return nil, fmt.Errorf("bad ipv6 start %q", start)
+		}
+		bits, err := strconv.Atoi(value)
+		if err != nil || bits < 0 || bits > 128 {
+			return nil, fmt.Errorf("bad ipv6 prefix length %q", value)
+		}
+		return []netip.Prefix{netip.PrefixFrom(first, bits).Masked()}, nil
+
+	default:
+		return nil, nil
+	}
+}
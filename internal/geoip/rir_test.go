@@ -0,0 +1,52 @@
+package geoip
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadRIRUnknownCountry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "delegated-test")
+	content := `2|ripencc|20260101|3|19830705|20260101|+0100
+ripencc|*|ipv4|*|2|summary
+ripencc|DE|ipv4|10.0.0.0|256|20100101|allocated
+ripencc||ipv4|10.0.1.0|256|20100101|assigned
+ripencc|UK|ipv6|2001:db8::|32|20100101|allocated
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadRIR(context.Background(), []string{path}, DefaultOptions())
+	if err != nil {
+		t.Fatalf("LoadRIR: %v", err)
+	}
+	tests := []struct {
+		addr string
+		iso  string
+		src  CodeSource
+	}{
+		{"10.0.0.1", "DE", SourceRegisteredCountry},
+		{"10.0.1.1", UnknownISO, SourceFallback},
+		{"2001:db8::1", "GB", SourceRegisteredCountry},
+	}
+	for _, tt := range tests {
+		_, iso, src, ok := s.Lookup(netip.MustParseAddr(tt.addr))
+		if !ok || iso != tt.iso || src != tt.src {
+			t.Errorf("Lookup(%s) = %s %s %v, want %s %s", tt.addr, iso, src, ok, tt.iso, tt.src)
+		}
+	}
+
+	opt := DefaultOptions()
+	opt.SkipUnknown = true
+	s, err = LoadRIR(context.Background(), []string{path}, opt)
+	if err != nil {
+		t.Fatalf("LoadRIR: %v", err)
+	}
+	if p, iso, _, ok := s.Lookup(netip.MustParseAddr("10.0.1.1")); ok {
+		t.Errorf("Lookup(10.0.1.1) with SkipUnknown = %s %s, want not found", p, iso)
+	}
+}
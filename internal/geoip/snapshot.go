@@ -0,0 +1,482 @@
+package geoip
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"maps"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Snapshot layout (little endian, strings are uint32 length + bytes):
+//
+//	magic "GEOSNAP\x00", version uint32, key string
+//	unknownISO string, preference string (comma-separated fields), dataset
+//	layers:    uint32 count, {name string, dataset, networks uint32}
+//	countries: uint32 count, {iso string, name string} (index = CountryID)
+//	overrides: uint32 count, {cidr string, iso, comment string, expires int64, origin string}
+//	previous:  uint32 count, {iso string, networks uint32, ipv4 uint64, ipv6 /64s uint64}
+//	ipv4:      uint32 count, {addr [4]byte, bits uint8, id, reg uint16, src uint8, layer uint8}
+//	ipv6:      uint32 count, {addr [16]byte, bits uint8, id, reg uint16, src uint8, layer uint8}
+//	shadowed:  uint32 count, {cidr string, id, reg uint16, src uint8, layer uint8}
+//	nested:    uint8
+//	located:   (countries+1) uint32 group starts, then one uint32 network index per network
+//	registered: the same, grouped by registered country
+//	space:     per country {ipv4 uint64, ipv6 hi, lo uint64, ipv4 lengths [33]uint32,
+//	           ipv6 lengths [129]uint32}, then the ipv4 total uint64 and the ipv6 total hi, lo uint64
+//	crc32 (IEEE) of everything above
+//
+// The sections after the overrides are the finalized store as kept in
+// memory: networks sorted by address, then length, and the per-country
+// indexes and address space computed from them. Loading copies them in as
+// they are, so a start from a snapshot does no sorting or grouping.
+const (
+	snapshotMagic   = "GEOSNAP\x00"
+	snapshotVersion = 5
+)
+
+// ErrSnapshotStale means the snapshot was built from other inputs (or one of
+// its overrides has expired since) and has to be rebuilt.
+var ErrSnapshotStale = errors.New("snapshot is stale")
+
+// SnapshotKey fingerprints the inputs a store is built from: the content of
+// every file plus any extra settings that change the result.
+func SnapshotKey(files []string, extra ...string) (string, error) {
+	h := sha256.New()
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "file %s\n", path)
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return "", fmt.Errorf("hash %s: %w", path, err)
+		}
+	}
+	for _, e := range extra {
+		fmt.Fprintf(h, "opt %s\n", e)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// WriteSnapshot serializes the finalized store. key is stored as is and
+// checked by ReadSnapshot.
+func (s *Store) WriteSnapshot(w io.Writer, key string) error {
+	var b []byte
+	b = append(b, snapshotMagic...)
+	b = binary.LittleEndian.AppendUint32(b, snapshotVersion)
+	b = appendString(b, key)
+	b = appendString(b, s.UnknownISO())
+	pref := make([]string, len(s.Preference()))
+	for i, src := range s.Preference() {
+		pref[i] = src.String()
+	}
+	b = appendString(b, strings.Join(pref, ","))
+	b = appendDataset(b, s.dataset)
+
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.layers)))
+	for _, l := range s.layers {
+		b = appendString(b, l.Name)
+		b = appendDataset(b, l.Dataset)
+		b = binary.LittleEndian.AppendUint32(b, uint32(l.Networks))
+	}
+
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.isoByID)))
+	for id, iso := range s.isoByID {
+		b = appendString(b, iso)
+		b = appendString(b, s.nameByID[id])
+	}
+
+	var overrides []Override
+	if s.overrides != nil {
+		overrides = s.overrides.items
+	}
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(overrides)))
+	for _, o := range overrides {
+		b = appendString(b, o.Prefix.String())
+		b = appendString(b, o.ISO)
+		b = appendString(b, o.Comment)
+		var expires int64
+		if !o.Expires.IsZero() {
+			expires = o.Expires.UnixNano()
+		}
+		b = binary.LittleEndian.AppendUint64(b, uint64(expires))
+		b = appendString(b, o.Origin)
+	}
+
+	prev := slices.Sorted(maps.Keys(s.previous))
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(prev)))
+	for _, iso := range prev {
+		c := s.previous[iso]
+		b = appendString(b, iso)
+		b = binary.LittleEndian.AppendUint32(b, uint32(c.Networks))
+		b = binary.LittleEndian.AppendUint64(b, c.IPv4Addresses)
+		b = binary.LittleEndian.AppendUint64(b, c.IPv6Slash64s)
+	}
+
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.v4)))
+	for _, x := range s.v4 {
+		b = binary.BigEndian.AppendUint32(b, x.addr)
+		b = appendNetInfo(b, x.bits, x.info)
+	}
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.v6)))
+	for _, x := range s.v6 {
+		b = binary.BigEndian.AppendUint64(b, x.hi)
+		b = binary.BigEndian.AppendUint64(b, x.lo)
+		b = appendNetInfo(b, x.bits, x.info)
+	}
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s.shadowed.nets)))
+	for _, n := range s.shadowed.nets {
+		b = appendInfo(appendString(b, n.prefix.String()), n.info)
+	}
+
+	var nested uint8
+	if s.nested {
+		nested = 1
+	}
+	b = append(b, nested)
+	b = appendU32s(appendU32s(b, s.countryStart), s.byCountry)
+	b = appendU32s(appendU32s(b, s.registeredStart), s.byRegistered)
+
+	for i := range s.space {
+		cs := &s.space[i]
+		b = binary.LittleEndian.AppendUint64(b, cs.v4)
+		b = binary.LittleEndian.AppendUint64(b, cs.v6.hi)
+		b = binary.LittleEndian.AppendUint64(b, cs.v6.lo)
+		b = appendU32s(b, cs.v4Bits[:])
+		b = appendU32s(b, cs.v6Bits[:])
+	}
+	b = binary.LittleEndian.AppendUint64(b, s.v4Total)
+	b = binary.LittleEndian.AppendUint64(b, s.v6Total.hi)
+	b = binary.LittleEndian.AppendUint64(b, s.v6Total.lo)
+
+	b = binary.LittleEndian.AppendUint32(b, crc32.ChecksumIEEE(b))
+	_, err := w.Write(b)
+	return err
+}
+
+// ReadSnapshot loads a store written by WriteSnapshot with a single read.
+// It returns ErrSnapshotStale when the snapshot was written for another key.
+func ReadSnapshot(path, key string) (*Store, error) {
+	return readSnapshot(path, key, false)
+}
+
+// ReadPreviousSnapshot loads a snapshot whatever inputs it was built from,
+// expired overrides included. It is how a rebuild learns what the store it
+// replaces looked like; see SetPrevious.
+func ReadPreviousSnapshot(path string) (*Store, error) {
+	return readSnapshot(path, "", true)
+}
+
+func readSnapshot(path, key string, anyKey bool) (*Store, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < len(snapshotMagic)+8 || string(data[:len(snapshotMagic)]) != snapshotMagic {
+		return nil, errors.New("not a geoip snapshot")
+	}
+	body, sum := data[:len(data)-4], binary.LittleEndian.Uint32(data[len(data)-4:])
+	if crc32.ChecksumIEEE(body) != sum {
+		return nil, errors.New("snapshot checksum mismatch")
+	}
+
+	r := &snapReader{b: body, off: len(snapshotMagic)}
+	if v := r.u32(); v != snapshotVersion {
+		return nil, fmt.Errorf("%w: format version %d", ErrSnapshotStale, v)
+	}
+	if k := r.str(); k != key && !anyKey {
+		return nil, ErrSnapshotStale
+	}
+	unknownISO := r.str()
+	var preference []CodeSource
+	for _, name := range strings.Split(r.str(), ",") {
+		src, ok := ParseCodeSource(name)
+		if !ok && r.err == nil {
+			return nil, fmt.Errorf("snapshot preference %q is invalid", name)
+		}
+		preference = append(preference, src)
+	}
+	dataset := r.dataset()
+
+	layers := make([]SourceInfo, r.count(1))
+	for i := range layers {
+		layers[i] = SourceInfo{Name: r.str(), Dataset: r.dataset(), Networks: int(r.u32())}
+	}
+
+	s := &Store{
+		idByISO:    make(map[string]CountryID),
+		unknownISO: unknownISO,
+		preference: preference,
+		dataset:    dataset,
+		layers:     layers,
+	}
+	nc := r.count(8)
+	s.isoByID, s.nameByID = make([]string, nc), make([]string, nc)
+	for id := range nc {
+		s.isoByID[id], s.nameByID[id] = r.str(), r.str()
+		s.idByISO[s.isoByID[id]] = CountryID(id)
+	}
+
+	now := time.Now()
+	overrides := make([]Override, r.count(24))
+	for i := range overrides {
+		o := Override{}
+		cidr := r.str()
+		o.ISO, o.Comment = r.str(), r.str()
+		if ns := int64(r.u64()); ns != 0 {
+			o.Expires = time.Unix(0, ns)
+		}
+		o.Origin = r.str()
+		if r.err != nil {
+			break
+		}
+		if o.Prefix, err = netip.ParsePrefix(cidr); err != nil {
+			return nil, fmt.Errorf("snapshot override %q: %w", cidr, err)
+		}
+		if o.Expired(now) && !anyKey {
+			return nil, fmt.Errorf("%w: override %s expired", ErrSnapshotStale, o.Prefix)
+		}
+		overrides[i] = o
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	s.overrides = newOverrideSet(overrides, now)
+
+	if n := r.count(24); n > 0 {
+		s.previous = make(map[string]CountryCount, n)
+		for range n {
+			iso := r.str()
+			s.previous[iso] = CountryCount{Networks: int(r.u32()), IPv4Addresses: r.u64(), IPv6Slash64s: r.u64()}
+		}
+	}
+
+	valid := func(bits, maxBits uint8, ni netInfo) bool {
+		return bits <= maxBits && int(ni.id) < nc && int(ni.reg) < nc && int(ni.layer) < len(layers)
+	}
+	s.v4 = make([]v4Net, r.count(11))
+	for i := range s.v4 {
+		rec := r.bytes(11)
+		if rec == nil {
+			break
+		}
+		x := &s.v4[i]
+		x.addr = binary.BigEndian.Uint32(rec)
+		x.bits, x.info = readNetInfo(rec[4:])
+		if !valid(x.bits, 32, x.info) {
+			return nil, fmt.Errorf("corrupt snapshot network %d", i)
+		}
+	}
+	s.v6 = make([]v6Net, r.count(23))
+	for i := range s.v6 {
+		rec := r.bytes(23)
+		if rec == nil {
+			break
+		}
+		x := &s.v6[i]
+		x.hi, x.lo = binary.BigEndian.Uint64(rec), binary.BigEndian.Uint64(rec[8:])
+		x.bits, x.info = readNetInfo(rec[16:])
+		if !valid(x.bits, 128, x.info) {
+			return nil, fmt.Errorf("corrupt snapshot network %d", len(s.v4)+i)
+		}
+	}
+	shadowed := make([]shadowNet, r.count(10))
+	for i := range shadowed {
+		cidr := r.str()
+		rec := r.bytes(6)
+		if rec == nil {
+			break
+		}
+		n := &shadowed[i]
+		if n.prefix, err = netip.ParsePrefix(cidr); err != nil {
+			return nil, fmt.Errorf("snapshot shadowed network %q: %w", cidr, err)
+		}
+		n.info = readInfo(rec)
+		if !valid(uint8(n.prefix.Bits()), 128, n.info) {
+			return nil, fmt.Errorf("corrupt snapshot shadowed network %s", n.prefix)
+		}
+	}
+	s.shadowed = newShadowSet(shadowed)
+	if b := r.bytes(1); b != nil {
+		s.nested = b[0] != 0
+	}
+
+	total := len(s.v4) + len(s.v6)
+	s.countryStart, s.byCountry = r.index(nc, total)
+	s.registeredStart, s.byRegistered = r.index(nc, total)
+
+	s.space = make([]countrySpace, nc)
+	for i := range s.space {
+		cs := &s.space[i]
+		cs.v4, cs.v6 = r.u64(), u128{hi: r.u64(), lo: r.u64()}
+		r.u32s(cs.v4Bits[:])
+		r.u32s(cs.v6Bits[:])
+	}
+	s.v4Total, s.v6Total = r.u64(), u128{hi: r.u64(), lo: r.u64()}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	if r.off != len(body) {
+		return nil, errors.New("snapshot has trailing data")
+	}
+
+	s.countStats()
+	s.stats.ActiveOverrides = len(s.overrides.items)
+	return s, nil
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func appendU32s(b []byte, list []uint32) []byte {
+	for _, v := range list {
+		b = binary.LittleEndian.AppendUint32(b, v)
+	}
+	return b
+}
+
+// appendNetInfo appends the part of a network record after the address.
+func appendNetInfo(b []byte, bits uint8, ni netInfo) []byte {
+	return appendInfo(append(b, bits), ni)
+}
+
+func readNetInfo(rec []byte) (uint8, netInfo) {
+	return rec[0], readInfo(rec[1:])
+}
+
+// appendInfo appends the part of a network record after the length.
+func appendInfo(b []byte, ni netInfo) []byte {
+	b = binary.LittleEndian.AppendUint16(b, uint16(ni.id))
+	b = binary.LittleEndian.AppendUint16(b, uint16(ni.reg))
+	return append(b, uint8(ni.src), ni.layer)
+}
+
+func readInfo(rec []byte) netInfo {
+	return netInfo{
+		id:    CountryID(binary.LittleEndian.Uint16(rec[0:2])),
+		reg:   CountryID(binary.LittleEndian.Uint16(rec[2:4])),
+		src:   CodeSource(rec[4]),
+		layer: rec[5],
+	}
+}
+
+func appendDataset(b []byte, ds DatasetInfo) []byte {
+	b = appendString(b, ds.Type)
+	var built int64
+	if !ds.BuildTime.IsZero() {
+		built = ds.BuildTime.Unix()
+	}
+	b = binary.LittleEndian.AppendUint64(b, uint64(built))
+	return appendString(b, ds.Description)
+}
+
+// snapReader decodes a snapshot body; the first error sticks and turns every
+// further read into a zero value.
+type snapReader struct {
+	b   []byte
+	off int
+	err error
+}
+
+func (r *snapReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.b)-r.off {
+		r.err = errors.New("snapshot is truncated")
+		return nil
+	}
+	out := r.b[r.off : r.off+n]
+	r.off += n
+	return out
+}
+
+func (r *snapReader) u32() uint32 {
+	if b := r.bytes(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (r *snapReader) u64() uint64 {
+	if b := r.bytes(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+// u32s fills dst.
+func (r *snapReader) u32s(dst []uint32) {
+	b := r.bytes(4 * len(dst))
+	if b == nil {
+		return
+	}
+	for i := range dst {
+		dst[i] = binary.LittleEndian.Uint32(b[4*i:])
+	}
+}
+
+// index reads a country grouping of total networks over nc countries, as
+// kept in Store.byCountry and Store.countryStart.
+func (r *snapReader) index(nc, total int) (start, idx []uint32) {
+	if r.err == nil && (nc+1+total)*4 > len(r.b)-r.off {
+		r.err = errors.New("snapshot is truncated")
+	}
+	if r.err != nil {
+		return nil, nil
+	}
+	start, idx = make([]uint32, nc+1), make([]uint32, total)
+	r.u32s(start)
+	r.u32s(idx)
+	ok := start[0] == 0 && int(start[nc]) == total
+	for i := 1; i <= nc && ok; i++ {
+		ok = start[i] >= start[i-1]
+	}
+	for i := 0; i < total && ok; i++ {
+		ok = int(idx[i]) < total
+	}
+	if !ok {
+		r.err = errors.New("corrupt snapshot country index")
+	}
+	return start, idx
+}
+
+func (r *snapReader) str() string {
+	return string(r.bytes(int(r.u32())))
+}
+
+// count reads an element count and checks that at least minSize bytes per
+// element are left, so a corrupt count cannot trigger a huge allocation.
+func (r *snapReader) count(minSize int) int {
+	n := int(r.u32())
+	if r.err == nil && n > (len(r.b)-r.off)/minSize {
+		r.err = errors.New("snapshot is truncated")
+	}
+	if r.err != nil {
+		return 0
+	}
+	return n
+}
+
+func (r *snapReader) dataset() DatasetInfo {
+	ds := DatasetInfo{Type: r.str()}
+	if built := int64(r.u64()); built != 0 {
+		ds.BuildTime = time.Unix(built, 0).UTC()
+	}
+	ds.Description = r.str()
+	return ds
+}
@@ -0,0 +1,96 @@
+package geoip
+
+import (
+	"bytes"
+	"errors"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	s := buildTestStore(t, []testNetwork{
+		{"10.0.0.0/8", "DE", SourceCountry},
+		{"10.1.0.0/16", "FR", SourceRegisteredCountry},
+		{"10.1.2.0/24", "NL", SourceCountry},
+		{"192.0.2.0/24", "ZZ", SourceFallback},
+		{"2001:db8::/32", "US", SourceCountry},
+		{"2001:db8:1::/48", "DE", SourceCountry},
+	}, []Override{
+		{Prefix: netip.MustParsePrefix("10.9.0.0/16"), ISO: "PL", Expires: time.Now().Add(time.Hour).Round(0)},
+	})
+
+	var buf bytes.Buffer
+	if err := s.WriteSnapshot(&buf, "key"); err != nil {
+		t.Fatalf("WriteSnapshot: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "snap.bin")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ReadSnapshot(path, "key")
+	if err != nil {
+		t.Fatalf("ReadSnapshot: %v", err)
+	}
+
+	// The finalized state is loaded as written, not recomputed.
+	fields := []struct {
+		name      string
+		got, want any
+	}{
+		{"isoByID", got.isoByID, s.isoByID},
+		{"nameByID", got.nameByID, s.nameByID},
+		{"idByISO", got.idByISO, s.idByISO},
+		{"v4", got.v4, s.v4},
+		{"v6", got.v6, s.v6},
+		{"nested", got.nested, s.nested},
+		{"byCountry", got.byCountry, s.byCountry},
+		{"countryStart", got.countryStart, s.countryStart},
+		{"byRegistered", got.byRegistered, s.byRegistered},
+		{"registeredStart", got.registeredStart, s.registeredStart},
+		{"space", got.space, s.space},
+		{"v4Total", got.v4Total, s.v4Total},
+		{"v6Total", got.v6Total, s.v6Total},
+		{"stats", got.stats, s.stats},
+		{"overrides", got.overrides.items, s.overrides.items},
+		{"shadowed", got.shadowed, s.shadowed},
+	}
+	for _, f := range fields {
+		if !reflect.DeepEqual(f.got, f.want) {
+			t.Errorf("%s = %v, want %v", f.name, f.got, f.want)
+		}
+	}
+	for i, l := range got.Sources() {
+		if want := s.Sources()[i]; l.Name != want.Name || l.Networks != want.Networks {
+			t.Errorf("source %d = %s (%d networks), want %s (%d)", i, l.Name, l.Networks, want.Name, want.Networks)
+		}
+	}
+
+	if o, ok := got.OverrideFor(netip.MustParseAddr("10.9.1.1")); !ok || o.ISO != "PL" {
+		t.Errorf("OverrideFor(10.9.1.1) = %+v, %v, want PL", o, ok)
+	}
+	if n, ok := got.NetworkOf(netip.MustParsePrefix("10.1.2.0/24")); !ok || n.ISO != "NL" {
+		t.Errorf("NetworkOf(10.1.2.0/24) = %+v, %v, want NL", n, ok)
+	}
+	if ranges, ok := got.RangesByBasis("FR", BasisRegistered); !ok || ranges.Len() != 1 {
+		t.Errorf("RangesByBasis(FR, registered) = %v, %v", ranges.Prefixes(), ok)
+	}
+
+	if _, err := ReadSnapshot(path, "other"); !errors.Is(err, ErrSnapshotStale) {
+		t.Errorf("ReadSnapshot with another key: err = %v, want ErrSnapshotStale", err)
+	}
+
+	// Any flipped byte fails the checksum.
+	b := bytes.Clone(buf.Bytes())
+	b[len(b)/2] ^= 0xff
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadSnapshot(path, "key"); err == nil {
+		t.Error("ReadSnapshot of a corrupt snapshot succeeded")
+	}
+}
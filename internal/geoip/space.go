@@ -0,0 +1,160 @@
+package geoip
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// PrefixCount is one bucket of a prefix-length histogram.
+type PrefixCount struct {
+	Bits     int
+	Networks int
+}
+
+// FamilySpace is the part of one address family a country answers for.
+type FamilySpace struct {
+	Networks  int
+	Addresses *big.Int
+	// Share is Addresses over the addresses of all countries, 0..1.
+	Share float64
+	// Prefixes is the histogram of network prefix lengths, shortest first;
+	// empty buckets are left out.
+	Prefixes []PrefixCount
+}
+
+// CountrySpace is the address space of a country. Where networks nest the
+// most specific one wins, as in lookups, so the spaces of all countries add
+// up to the space the dataset covers.
+type CountrySpace struct {
+	IPv4 FamilySpace
+	IPv6 FamilySpace
+}
+
+// u128 is an IPv6 address count. Sums saturate at the maximum rather than
+// wrap; only a ::/0 network gets there.
+type u128 struct {
+	hi, lo uint64
+}
+
+func (a u128) add(b u128) u128 {
+	lo, carry := bits.Add64(a.lo, b.lo, 0)
+	hi, carry := bits.Add64(a.hi, b.hi, carry)
+	if carry != 0 {
+		return u128{math.MaxUint64, math.MaxUint64}
+	}
+	return u128{hi, lo}
+}
+
+func (a u128) sub(b u128) u128 {
+	lo, borrow := bits.Sub64(a.lo, b.lo, 0)
+	hi, _ := bits.Sub64(a.hi, b.hi, borrow)
+	return u128{hi, lo}
+}
+
+func (a u128) big() *big.Int {
+	n := new(big.Int).SetUint64(a.hi)
+	n.Lsh(n, 64)
+	return n.Or(n, new(big.Int).SetUint64(a.lo))
+}
+
+func (a u128) float() float64 {
+	return float64(a.hi)*(1<<64) + float64(a.lo)
+}
+
+func v6Size(prefixBits uint8) u128 {
+	switch {
+	case prefixBits == 0:
+		return u128{math.MaxUint64, math.MaxUint64}
+	case prefixBits <= 64:
+		return u128{hi: 1 << (64 - prefixBits)}
+	default:
+		return u128{lo: 1 << (128 - prefixBits)}
+	}
+}
+
+// countrySpace is what finalize keeps per country.
+type countrySpace struct {
+	v4     uint64
+	v6     u128
+	v4Bits [33]uint32
+	v6Bits [129]uint32
+}
+
+// computeSpace fills s.space and the totals. Networks are in address order,
+// so a network's enclosing ones are on the stack when it is reached; its
+// addresses are taken from the innermost of them.
+func (s *Store) computeSpace() {
+	s.space = make([]countrySpace, len(s.isoByID))
+	s.v4Total, s.v6Total = 0, u128{}
+
+	var stack4 []int
+	for i, n := range s.v4 {
+		for len(stack4) > 0 && !s.v4[stack4[len(stack4)-1]].contains(n.addr) {
+			stack4 = stack4[:len(stack4)-1]
+		}
+		size := uint64(1) << (32 - n.bits)
+		if len(stack4) > 0 {
+			s.space[s.v4[stack4[len(stack4)-1]].info.id].v4 -= size
+		} else {
+			s.v4Total += size
+		}
+		cs := &s.space[n.info.id]
+		cs.v4 += size
+		cs.v4Bits[n.bits]++
+		stack4 = append(stack4, i)
+	}
+
+	var stack6 []int
+	for i, n := range s.v6 {
+		for len(stack6) > 0 && !s.v6[stack6[len(stack6)-1]].contains(n.hi, n.lo) {
+			stack6 = stack6[:len(stack6)-1]
+		}
+		size := v6Size(n.bits)
+		if len(stack6) > 0 {
+			parent := &s.space[s.v6[stack6[len(stack6)-1]].info.id]
+			parent.v6 = parent.v6.sub(size)
+		} else {
+			s.v6Total = s.v6Total.add(size)
+		}
+		cs := &s.space[n.info.id]
+		cs.v6 = cs.v6.add(size)
+		cs.v6Bits[n.bits]++
+		stack6 = append(stack6, i)
+	}
+}
+
+// CountrySpace returns the address space of iso.
+func (s *Store) CountrySpace(iso string) (CountrySpace, bool) {
+	id, ok := s.countryOf(iso)
+	if !ok {
+		return CountrySpace{}, false
+	}
+	cs := &s.space[id]
+
+	out := CountrySpace{
+		IPv4: FamilySpace{Addresses: new(big.Int).SetUint64(cs.v4)},
+		IPv6: FamilySpace{Addresses: cs.v6.big()},
+	}
+	if s.v4Total > 0 {
+		out.IPv4.Share = float64(cs.v4) / float64(s.v4Total)
+	}
+	if total := s.v6Total.float(); total > 0 {
+		out.IPv6.Share = cs.v6.float() / total
+	}
+	out.IPv4.Networks, out.IPv4.Prefixes = histogram(cs.v4Bits[:])
+	out.IPv6.Networks, out.IPv6.Prefixes = histogram(cs.v6Bits[:])
+	return out, true
+}
+
+func histogram(counts []uint32) (int, []PrefixCount) {
+	total := 0
+	var out []PrefixCount
+	for b, n := range counts {
+		if n > 0 {
+			total += int(n)
+			out = append(out, PrefixCount{Bits: b, Networks: int(n)})
+		}
+	}
+	return total, out
+}
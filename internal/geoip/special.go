@@ -0,0 +1,137 @@
+package geoip
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// AddrClass is the kind of special-purpose block an address belongs to.
+type AddrClass uint8
+
+const (
+	ClassNone AddrClass = iota // ordinary unicast space
+	ClassPrivate
+	ClassLoopback
+	ClassLinkLocal
+	ClassCGNAT
+	ClassDocumentation
+	ClassMulticast
+	ClassReserved
+	// ClassSpecial covers the remaining registry entries: anycast services,
+	// translation prefixes and tunnels.
+	ClassSpecial
+)
+
+var addrClassNames = [...]string{
+	ClassNone:          "none",
+	ClassPrivate:       "private",
+	ClassLoopback:      "loopback",
+	ClassLinkLocal:     "link_local",
+	ClassCGNAT:         "cgnat",
+	ClassDocumentation: "documentation",
+	ClassMulticast:     "multicast",
+	ClassReserved:      "reserved",
+	ClassSpecial:       "special",
+}
+
+func (c AddrClass) String() string {
+	if int(c) < len(addrClassNames) {
+		return addrClassNames[c]
+	}
+	return "none"
+}
+
+// SpecialBlock is an entry of the IANA IPv4 and IPv6 Special-Purpose Address
+// Registries. The multicast blocks, which have registries of their own, are
+// listed too.
+type SpecialBlock struct {
+	Prefix netip.Prefix
+	Class  AddrClass
+	Name   string // registry name, e.g. "Private-Use"
+	RFC    string
+
+	// reachable is the registry's "Globally Reachable" column: 1 true,
+	// -1 false, 0 N/A (the enclosing block decides).
+	reachable int8
+}
+
+//go:embed special.csv
+var specialCSV string
+
+var (
+	specialBlocks      []SpecialBlock // widest first
+	bogonsV4, bogonsV6 []netip.Prefix
+)
+
+func init() {
+	rows, err := csv.NewReader(strings.NewReader(specialCSV)).ReadAll()
+	if err != nil {
+		panic(fmt.Errorf("special.csv: %w", err))
+	}
+	for _, r := range rows[1:] {
+		b := SpecialBlock{Prefix: netip.MustParsePrefix(r[0]), Name: r[2], RFC: r[3]}
+		i := slices.Index(addrClassNames[:], r[1])
+		if i <= 0 {
+			panic(fmt.Errorf("special.csv: %s: unknown class %q", r[0], r[1]))
+		}
+		b.Class = AddrClass(i)
+		switch r[4] {
+		case "true":
+			b.reachable = 1
+		case "false":
+			b.reachable = -1
+		}
+		specialBlocks = append(specialBlocks, b)
+	}
+	slices.SortStableFunc(specialBlocks, func(a, b SpecialBlock) int { return a.Prefix.Bits() - b.Prefix.Bits() })
+
+	// Exceptions are nested in the blocks they except, so applying blocks
+	// widest first leaves the most specific answer.
+	var set NetSet
+	for _, b := range specialBlocks {
+		switch b.reachable {
+		case -1:
+			set = set.Union(NewNetSet(b.Prefix))
+		case 1:
+			set = set.Subtract(NewNetSet(b.Prefix))
+		}
+	}
+	for _, p := range set.Prefixes() {
+		if p.Addr().Is4() {
+			bogonsV4 = append(bogonsV4, p)
+		} else {
+			bogonsV6 = append(bogonsV6, p)
+		}
+	}
+}
+
+// Classify returns the most specific special-purpose block holding addr;
+// ok is false for ordinary address space. bogon reports that addr is not
+// globally reachable and so never a valid public source or destination.
+// IPv4-mapped addresses are classified as IPv4.
+func Classify(addr netip.Addr) (blk SpecialBlock, bogon, ok bool) {
+	addr = addr.Unmap().WithZone("")
+	for _, b := range specialBlocks {
+		if !b.Prefix.Contains(addr) {
+			continue
+		}
+		blk, ok = b, true
+		if b.reachable != 0 {
+			bogon = b.reachable < 0
+		}
+	}
+	return blk, bogon, ok
+}
+
+// Bogons returns the minimal prefix list of the addresses of one family
+// that are not globally reachable, in address order.
+func Bogons(ipv6 bool) []netip.Prefix {
+	if ipv6 {
+		return slices.Clone(bogonsV6)
+	}
+	return slices.Clone(bogonsV4)
+}
@@ -1,20 +1,37 @@
 package geoip
 
 import (
-	"encoding/binary"
 	"net/netip"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 )
 
 type CountryID uint16
-type v4Key uint64
 
-func makeV4Key(p netip.Prefix) v4Key {
-	a := p.Addr().As4()
-	ip := binary.BigEndian.Uint32(a[:])
-	bits := uint64(uint8(p.Bits())) // 0..32
-	return v4Key((bits << 32) | uint64(ip))
+// Basis selects which country a network is listed under: the located one
+// the store resolves to, or the registered one.
+type Basis uint8
+
+const (
+	BasisLocated Basis = iota
+	BasisRegistered
+)
+
+func (b Basis) String() string {
+	if b == BasisRegistered {
+		return "registered"
+	}
+	return "located"
+}
+
+// netInfo is what the store keeps per network.
+type netInfo struct {
+	id    CountryID
+	reg   CountryID // registered country; id when absent or the same
+	src   CodeSource
+	layer uint8 // index into Store.layers
 }
 
 type Stats struct {
@@ -22,50 +39,296 @@ type Stats struct {
 	UniqueCountries int
 	V4Networks      int
 	V6Networks      int
+	ActiveOverrides int
 }
 
 type Store struct {
-	isoByID []string
-	idByISO map[string]CountryID
+	isoByID  []string
+	idByISO  map[string]CountryID
+	nameByID []string // English country names, when the source has them
+
+	// Packed networks sorted by address, then length. Exact CIDR and
+	// address lookups are binary searches.
+	v4     []v4Net
+	v6     []v6Net
+	nested bool // some network contains another one
 
-	byCountry [][]netip.Prefix
+	// byCountry holds network indices grouped by country, each group in
+	// address order; index i < len(v4) is v4[i], otherwise v6[i-len(v4)].
+	// The group of country id is byCountry[countryStart[id]:countryStart[id+1]].
+	byCountry    []uint32
+	countryStart []uint32
+	// byRegistered and registeredStart group the same way by registered
+	// country.
+	byRegistered    []uint32
+	registeredStart []uint32
 
-	byV4 map[v4Key]CountryID        // O(1) exact CIDR lookup for IPv4
-	byV6 map[netip.Prefix]CountryID // O(1) exact CIDR lookup for IPv6
+	// space is indexed by CountryID; the totals cover all countries.
+	space   []countrySpace
+	v4Total uint64
+	v6Total u128
+
+	overrides  *overrideSet
+	shadowed   *shadowSet // database networks the overrides took space from
+	unknownISO string
+	preference []CodeSource // nil: DefaultPreference
+	dataset    DatasetInfo
+	layers     []SourceInfo // in precedence order
+
+	// previous holds the per-country counts of the build this store
+	// replaced, nil if none is known.
+	previous map[string]CountryCount
 
 	stats Stats
 }
 
+// add appends pfx; finalize sorts the networks and drops repeated prefixes,
+// keeping the first one added.
+func (s *Store) add(pfx netip.Prefix, ni netInfo) {
+	if pfx.Addr().Is4() {
+		s.v4 = append(s.v4, v4Net{addr: packV4(pfx.Addr()), bits: uint8(pfx.Bits()), info: ni})
+		return
+	}
+	hi, lo := packV6(pfx.Addr())
+	s.v6 = append(s.v6, v6Net{hi: hi, lo: lo, bits: uint8(pfx.Bits()), info: ni})
+}
+
+// netAt returns the network with index i (see byCountry).
+func (s *Store) netAt(i uint32) (netip.Prefix, netInfo) {
+	if n := uint32(len(s.v4)); i >= n {
+		x := s.v6[i-n]
+		return x.prefix(), x.info
+	}
+	x := s.v4[i]
+	return x.prefix(), x.info
+}
+
+// forEach calls fn for every stored network, in address order.
+func (s *Store) forEach(fn func(p netip.Prefix, ni netInfo)) {
+	for _, x := range s.v4 {
+		fn(x.prefix(), x.info)
+	}
+	for _, x := range s.v6 {
+		fn(x.prefix(), x.info)
+	}
+}
+
+// find looks up the exact network pfx (masked).
+func (s *Store) find(pfx netip.Prefix) (netInfo, bool) {
+	bits := uint8(pfx.Bits())
+	if pfx.Addr().Is4() {
+		addr := packV4(pfx.Addr())
+		i, ok := slices.BinarySearchFunc(s.v4, addr, func(x v4Net, a uint32) int { return x.compare(a, bits) })
+		if !ok {
+			return netInfo{}, false
+		}
+		return s.v4[i].info, true
+	}
+
+	hi, lo := packV6(pfx.Addr())
+	i, ok := slices.BinarySearchFunc(s.v6, v6Net{hi: hi, lo: lo}, func(x, t v6Net) int { return x.compare(t.hi, t.lo, bits) })
+	if !ok {
+		return netInfo{}, false
+	}
+	return s.v6[i].info, true
+}
+
+// Lookup finds the most specific stored network containing addr. It is used
+// when no database reader is available (non-MMDB sources).
+func (s *Store) Lookup(addr netip.Addr) (netip.Prefix, string, CodeSource, bool) {
+	addr = addr.WithZone("")
+	if addr.Is4In6() {
+		addr = addr.Unmap()
+	}
+
+	// The last network starting at or before addr is the most specific one
+	// containing it, if any does. Without nesting, no other can.
+	if addr.Is4() {
+		a := packV4(addr)
+		i := sort.Search(len(s.v4), func(i int) bool { return s.v4[i].addr > a }) - 1
+		if i >= 0 && s.v4[i].contains(a) {
+			return s.v4[i].prefix(), s.isoByID[s.v4[i].info.id], s.v4[i].info.src, true
+		}
+	} else {
+		hi, lo := packV6(addr)
+		i := sort.Search(len(s.v6), func(i int) bool {
+			x := s.v6[i]
+			return x.hi > hi || x.hi == hi && x.lo > lo
+		}) - 1
+		if i >= 0 && s.v6[i].contains(hi, lo) {
+			return s.v6[i].prefix(), s.isoByID[s.v6[i].info.id], s.v6[i].info.src, true
+		}
+	}
+	if !s.nested {
+		return netip.Prefix{}, "", SourceFallback, false
+	}
+
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		pfx := netip.PrefixFrom(addr, bits).Masked()
+		if ni, ok := s.find(pfx); ok {
+			return pfx, s.isoByID[ni.id], ni.src, true
+		}
+	}
+	return netip.Prefix{}, "", SourceFallback, false
+}
+
+// CountryName returns the English name of iso if the source provided one.
+func (s *Store) CountryName(iso string) string {
+	id, ok := s.idByISO[strings.ToUpper(strings.TrimSpace(iso))]
+	if !ok {
+		return ""
+	}
+	return s.nameByID[id]
+}
+
+// Dataset describes the data the store was built from.
+func (s *Store) Dataset() DatasetInfo {
+	return s.dataset
+}
+
+// Sources lists the layers the store was merged from, highest precedence
+// first.
+func (s *Store) Sources() []SourceInfo {
+	out := make([]SourceInfo, 0, len(s.layers))
+	// Overrides are added last but take precedence over every layer.
+	if n := len(s.layers); n > 0 && s.layers[n-1].Name == LayerOverrides {
+		out = append(out, s.layers[n-1])
+		return append(out, s.layers[:n-1]...)
+	}
+	return append(out, s.layers...)
+}
+
+// Shadowed finds the most specific database network containing addr that
+// an override took space from: what the data said before the override.
+func (s *Store) Shadowed(addr netip.Addr) (netip.Prefix, string, CodeSource, bool) {
+	if s.shadowed == nil {
+		return netip.Prefix{}, "", SourceFallback, false
+	}
+	n, ok := s.shadowed.match(addr.WithZone("").Unmap())
+	if !ok {
+		return netip.Prefix{}, "", SourceFallback, false
+	}
+	return n.prefix, s.isoByID[n.info.id], n.info.src, true
+}
+
+// infoOf returns the stored network pfx, or the database network pfx an
+// override took space from.
+func (s *Store) infoOf(pfx netip.Prefix) (netInfo, bool) {
+	if ni, ok := s.find(pfx); ok {
+		return ni, true
+	}
+	if s.shadowed != nil {
+		if n, ok := s.shadowed.find(pfx); ok {
+			return n.info, true
+		}
+	}
+	return netInfo{}, false
+}
+
+// RegisteredOf returns the registered country of the stored (or shadowed)
+// network pfx, or "" if pfx is neither. Networks without one report their
+// country.
+func (s *Store) RegisteredOf(pfx netip.Prefix) string {
+	ni, ok := s.infoOf(pfx.Masked())
+	if !ok {
+		return ""
+	}
+	return s.isoByID[ni.reg]
+}
+
+// ProvenanceOf returns the name of the layer the stored (or shadowed)
+// network pfx came from, or "" if pfx is neither.
+func (s *Store) ProvenanceOf(pfx netip.Prefix) string {
+	ni, ok := s.infoOf(pfx.Masked())
+	if !ok {
+		return ""
+	}
+	return s.layers[ni.layer].Name
+}
+
+// OverrideFor returns the most specific active override containing addr.
+// Overrides that expired after the store was built are skipped.
+func (s *Store) OverrideFor(addr netip.Addr) (Override, bool) {
+	if s.overrides == nil {
+		return Override{}, false
+	}
+	return s.overrides.match(addr.WithZone("").Unmap(), time.Now())
+}
+
+// NextExpiry returns when the first of the store's overrides expires. The
+// store still holds the override's networks (and misses the database ones
+// under them) after that, so it should be rebuilt then.
+func (s *Store) NextExpiry() (time.Time, bool) {
+	if s.overrides == nil {
+		return time.Time{}, false
+	}
+	return s.overrides.nextExpiry()
+}
+
 func (s *Store) Stats() Stats {
 	return s.stats
 }
 
-func (s *Store) RangesCountByCountry(iso string) int {
-	iso = strings.ToUpper(strings.TrimSpace(iso))
-	id, ok := s.idByISO[iso]
-	if !ok {
-		return 0
+// Preference is the order of record fields the store was loaded with.
+func (s *Store) Preference() []CodeSource {
+	if s.preference == nil {
+		return DefaultPreference
 	}
-	return len(s.byCountry[id])
+	return s.preference
 }
 
+// UnknownISO is the fallback code the store was loaded with.
+func (s *Store) UnknownISO() string {
+	if s.unknownISO == "" {
+		return UnknownISO
+	}
+	return s.unknownISO
+}
+
+func (s *Store) RangesCountByCountry(iso string) int {
+	r, _ := s.RangesByCountry(iso)
+	return r.Len()
+}
+
+// CountryCodes lists the countries that have networks. Countries seen only
+// as registered_country are left out.
 func (s *Store) CountryCodes() []string {
-	out := make([]string, len(s.isoByID))
-	copy(out, s.isoByID)
+	out := make([]string, 0, len(s.isoByID))
+	for id, iso := range s.isoByID {
+		if s.countryStart[id+1] > s.countryStart[id] {
+			out = append(out, iso)
+		}
+	}
 	sort.Strings(out)
 	return out
 }
 
-func (s *Store) RangesByCountry(iso string) []netip.Prefix {
-	iso = strings.ToUpper(strings.TrimSpace(iso))
-	id, ok := s.idByISO[iso]
-	if !ok {
-		return nil
+// countryOf returns the ID of iso if the country has networks.
+func (s *Store) countryOf(iso string) (CountryID, bool) {
+	id, ok := s.idByISO[strings.ToUpper(strings.TrimSpace(iso))]
+	if !ok || s.countryStart[id+1] == s.countryStart[id] {
+		return 0, false
 	}
-	src := s.byCountry[id]
-	out := make([]netip.Prefix, len(src))
-	copy(out, src)
-	return out
+	return id, true
+}
+
+// RangesByCountry returns a view of the networks of iso. The view shares
+// the store's arrays and stays valid for the store's lifetime.
+func (s *Store) RangesByCountry(iso string) (Ranges, bool) {
+	return s.RangesByBasis(iso, BasisLocated)
+}
+
+// RangesByBasis is RangesByCountry with the country taken from basis.
+func (s *Store) RangesByBasis(iso string, basis Basis) (Ranges, bool) {
+	idx, start := s.byCountry, s.countryStart
+	if basis == BasisRegistered {
+		idx, start = s.byRegistered, s.registeredStart
+	}
+	id, ok := s.idByISO[strings.ToUpper(strings.TrimSpace(iso))]
+	if !ok || start[id+1] == start[id] {
+		return Ranges{}, false
+	}
+	return Ranges{s: s, idx: idx[start[id]:start[id+1]]}, true
 }
 
 func (s *Store) CountryByCIDR(cidr string) (string, bool) {
@@ -73,45 +336,95 @@ func (s *Store) CountryByCIDR(cidr string) (string, bool) {
 	if err != nil {
 		return "", false
 	}
-	p = p.Masked()
 
-	var (
-		id CountryID
-		ok bool
-	)
-
-	if p.Addr().Is4() {
-		id, ok = s.byV4[makeV4Key(p)]
-	} else {
-		id, ok = s.byV6[p]
-	}
+	ni, ok := s.find(p.Masked())
 	if !ok {
 		return "", false
 	}
-	return s.isoByID[id], true
+	return s.isoByID[ni.id], true
 }
 
 func (s *Store) finalize() {
-	for i := range s.byCountry {
-		rs := s.byCountry[i]
-		sort.Slice(rs, func(a, b int) bool {
-			ai, aj := rs[a].Addr(), rs[b].Addr()
-			if ai != aj {
-				return ai.Less(aj)
-			}
-			return rs[a].Bits() < rs[b].Bits()
-		})
-		s.byCountry[i] = rs
-	}
-	s.stats.UniqueCountries = len(s.isoByID)
-}
-
-// RangesByCountryUnsafe возвращает внутренний слайс (не копировать, не модифицировать!)
-func (s *Store) RangesByCountryUnsafe(iso string) ([]netip.Prefix, bool) {
-	iso = strings.ToUpper(strings.TrimSpace(iso))
-	id, ok := s.idByISO[iso]
-	if !ok {
-		return nil, false
+	slices.SortStableFunc(s.v4, func(a, b v4Net) int { return a.compare(b.addr, b.bits) })
+	s.v4 = compactNets(slices.CompactFunc(s.v4, func(a, b v4Net) bool {
+		return a.addr == b.addr && a.bits == b.bits
+	}))
+	slices.SortStableFunc(s.v6, func(a, b v6Net) int { return a.compare(b.hi, b.lo, b.bits) })
+	s.v6 = compactNets(slices.CompactFunc(s.v6, func(a, b v6Net) bool {
+		return a.hi == b.hi && a.lo == b.lo && a.bits == b.bits
+	}))
+
+	// In address order a network containing others is directly followed by
+	// one of them.
+	s.nested = false
+	for i := 1; i < len(s.v4) && !s.nested; i++ {
+		s.nested = s.v4[i-1].contains(s.v4[i].addr)
+	}
+	for i := 1; i < len(s.v6) && !s.nested; i++ {
+		s.nested = s.v6[i-1].contains(s.v6[i].hi, s.v6[i].lo)
+	}
+
+	for i := range s.layers {
+		s.layers[i].Networks = 0
+	}
+	for _, x := range s.v4 {
+		s.layers[x.info.layer].Networks++
+	}
+	for _, x := range s.v6 {
+		s.layers[x.info.layer].Networks++
+	}
+	s.byCountry, s.countryStart = s.group(func(ni netInfo) CountryID { return ni.id })
+	s.byRegistered, s.registeredStart = s.group(func(ni netInfo) CountryID { return ni.reg })
+	s.computeSpace()
+	s.countStats()
+}
+
+// countStats fills the network and country counts of the stats.
+func (s *Store) countStats() {
+	start := s.countryStart
+	s.stats.V4Networks = len(s.v4)
+	s.stats.V6Networks = len(s.v6)
+	s.stats.TotalNetworks = len(s.v4) + len(s.v6)
+	s.stats.UniqueCountries = 0
+	for id := range s.isoByID {
+		if start[id+1] > start[id] {
+			s.stats.UniqueCountries++
+		}
+	}
+}
+
+// group orders network indices by the country key picks with a counting
+// sort; address order within each country is kept.
+func (s *Store) group(key func(netInfo) CountryID) (idx, start []uint32) {
+	n := len(s.isoByID)
+	start = make([]uint32, n+1)
+	for _, x := range s.v4 {
+		start[key(x.info)+1]++
+	}
+	for _, x := range s.v6 {
+		start[key(x.info)+1]++
+	}
+	for i := 1; i <= n; i++ {
+		start[i] += start[i-1]
+	}
+
+	pos := slices.Clone(start[:n])
+	idx = make([]uint32, len(s.v4)+len(s.v6))
+	for i, x := range s.v4 {
+		idx[pos[key(x.info)]] = uint32(i)
+		pos[key(x.info)]++
+	}
+	for i, x := range s.v6 {
+		idx[pos[key(x.info)]] = uint32(len(s.v4) + i)
+		pos[key(x.info)]++
+	}
+	return idx, start
+}
+
+// compactNets releases the spare capacity left by build-time preallocation.
+func compactNets[E any](list []E) []E {
+	if cap(list)-len(list) > len(list)/8 {
+		return slices.Clone(list)
 	}
-	return s.byCountry[id], true
+	return list
 }
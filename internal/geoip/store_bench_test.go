@@ -0,0 +1,278 @@
+package geoip
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/rand/v2"
+	"net/netip"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// The benchmarks compare the packed store with the map-based layout it
+// replaced (mapStore below): retained heap after a build, exact CIDR
+// lookups, longest-prefix lookups and walking a country's networks.
+//
+//	go test ./internal/geoip -run '^$' -bench . -benchmem
+
+const (
+	benchV4Networks = 600_000
+	benchV6Networks = 500_000
+	benchCountries  = 250
+)
+
+type benchNetwork struct {
+	pfx netip.Prefix
+	iso string
+}
+
+var benchData = sync.OnceValue(func() []benchNetwork {
+	rng := rand.New(rand.NewPCG(1, 2))
+	out := make([]benchNetwork, 0, benchV4Networks+benchV6Networks)
+	iso := func() string {
+		n := rng.IntN(benchCountries)
+		return string([]byte{'A' + byte(n/26), 'A' + byte(n%26)})
+	}
+
+	// Disjoint /20../24 IPv4 networks with small gaps, like a country DB.
+	cursor := uint32(1 << 24)
+	for len(out) < benchV4Networks {
+		bits := 20 + rng.IntN(5)
+		size := uint32(1) << (32 - bits)
+		cursor = (cursor + size - 1) &^ (size - 1)
+		var a [4]byte
+		binary.BigEndian.PutUint32(a[:], cursor)
+		out = append(out, benchNetwork{netip.PrefixFrom(netip.AddrFrom4(a), bits), iso()})
+		cursor += size * uint32(1+rng.IntN(2))
+	}
+
+	// Disjoint /32../48 IPv6 networks inside 2000::/3.
+	hi := uint64(0x2000) << 48
+	for len(out) < benchV4Networks+benchV6Networks {
+		bits := 32 + rng.IntN(17)
+		size := uint64(1) << (64 - bits)
+		hi = (hi + size - 1) &^ (size - 1)
+		var a [16]byte
+		binary.BigEndian.PutUint64(a[:8], hi)
+		out = append(out, benchNetwork{netip.PrefixFrom(netip.AddrFrom16(a), bits), iso()})
+		hi += size * uint64(1+rng.IntN(2))
+	}
+	return out
+})
+
+func buildBenchStore(data []benchNetwork) *Store {
+	b := newBuilder(DefaultOptions(), "bench", benchV4Networks, benchV6Networks)
+	for _, n := range data {
+		b.addNetwork(n.pfx, n.iso, "", SourceCountry)
+	}
+	return b.finish(DatasetInfo{Type: "bench"})
+}
+
+// mapStore is the previous map-based layout, kept as a baseline.
+type mapStore struct {
+	idByISO   map[string]CountryID
+	isoByID   []string
+	byCountry [][]netip.Prefix
+	byV4      map[uint64]netInfo
+	byV6      map[netip.Prefix]netInfo
+}
+
+func mapV4Key(p netip.Prefix) uint64 {
+	return uint64(p.Bits())<<32 | uint64(packV4(p.Addr()))
+}
+
+func buildMapStore(data []benchNetwork) *mapStore {
+	s := &mapStore{
+		idByISO: make(map[string]CountryID, 256),
+		byV4:    make(map[uint64]netInfo, benchV4Networks),
+		byV6:    make(map[netip.Prefix]netInfo, benchV6Networks),
+	}
+	for _, n := range data {
+		id, ok := s.idByISO[n.iso]
+		if !ok {
+			id = CountryID(len(s.isoByID))
+			s.idByISO[n.iso] = id
+			s.isoByID = append(s.isoByID, n.iso)
+			s.byCountry = append(s.byCountry, nil)
+		}
+		if n.pfx.Addr().Is4() {
+			s.byV4[mapV4Key(n.pfx)] = netInfo{id: id}
+		} else {
+			s.byV6[n.pfx] = netInfo{id: id}
+		}
+		s.byCountry[id] = append(s.byCountry[id], n.pfx)
+	}
+	return s
+}
+
+func (s *mapStore) countryByCIDR(p netip.Prefix) (string, bool) {
+	var (
+		ni netInfo
+		ok bool
+	)
+	if p.Addr().Is4() {
+		ni, ok = s.byV4[mapV4Key(p)]
+	} else {
+		ni, ok = s.byV6[p]
+	}
+	return s.isoByID[ni.id], ok
+}
+
+func (s *mapStore) lookup(addr netip.Addr) (string, bool) {
+	for bits := addr.BitLen(); bits >= 0; bits-- {
+		if iso, ok := s.countryByCIDR(netip.PrefixFrom(addr, bits).Masked()); ok {
+			return iso, true
+		}
+	}
+	return "", false
+}
+
+// retainedHeap reports the live heap held by the value build returns.
+func retainedHeap(b *testing.B, build func() any) {
+	var before, after runtime.MemStats
+	for i := 0; i < b.N; i++ {
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		v := build()
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		runtime.KeepAlive(v)
+		b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/(1<<20), "heap-MB")
+	}
+}
+
+func BenchmarkStoreHeap(b *testing.B) {
+	data := benchData()
+	b.Run("packed", func(b *testing.B) {
+		retainedHeap(b, func() any { return buildBenchStore(data) })
+	})
+	b.Run("maps", func(b *testing.B) {
+		retainedHeap(b, func() any { return buildMapStore(data) })
+	})
+}
+
+// benchQueries returns stored networks and addresses inside them, in random
+// order.
+func benchQueries(data []benchNetwork) ([]netip.Prefix, []netip.Addr) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	prefixes := make([]netip.Prefix, 4096)
+	addrs := make([]netip.Addr, len(prefixes))
+	for i := range prefixes {
+		p := data[rng.IntN(len(data))].pfx
+		prefixes[i] = p
+		addrs[i] = lastAddr(p)
+	}
+	return prefixes, addrs
+}
+
+func BenchmarkCountryByCIDR(b *testing.B) {
+	data := benchData()
+	prefixes, _ := benchQueries(data)
+	packed, maps := buildBenchStore(data), buildMapStore(data)
+
+	b.Run("packed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := packed.find(prefixes[i%len(prefixes)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+	b.Run("maps", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, ok := maps.countryByCIDR(prefixes[i%len(prefixes)]); !ok {
+				b.Fatal("not found")
+			}
+		}
+	})
+}
+
+func BenchmarkLookup(b *testing.B) {
+	data := benchData()
+	_, addrs := benchQueries(data)
+	packed, maps := buildBenchStore(data), buildMapStore(data)
+
+	for _, family := range []string{"ipv4", "ipv6"} {
+		var list []netip.Addr
+		for _, a := range addrs {
+			if a.Is4() == (family == "ipv4") {
+				list = append(list, a)
+			}
+		}
+
+		b.Run(fmt.Sprintf("packed/%s", family), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, _, ok := packed.Lookup(list[i%len(list)]); !ok {
+					b.Fatal("not found")
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("maps/%s", family), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, ok := maps.lookup(list[i%len(list)]); !ok {
+					b.Fatal("not found")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkRangesByCountry(b *testing.B) {
+	data := benchData()
+	packed, maps := buildBenchStore(data), buildMapStore(data)
+	iso := data[0].iso
+
+	b.Run("packed", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			r, _ := packed.RangesByCountry(iso)
+			var n int
+			for _, p := range r.All() {
+				n += p.Bits()
+			}
+			runtime.KeepAlive(n)
+		}
+	})
+	b.Run("maps", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var n int
+			for _, p := range maps.byCountry[maps.idByISO[iso]] {
+				n += p.Bits()
+			}
+			runtime.KeepAlive(n)
+		}
+	})
+}
+
+func TestStoreMatchesMapLayout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("large synthetic dataset")
+	}
+	data := benchData()[:50_000]
+	data = append(data, benchData()[benchV4Networks:benchV4Networks+50_000]...)
+	packed, maps := buildBenchStore(data), buildMapStore(data)
+
+	prefixes, addrs := benchQueries(data)
+	for _, p := range prefixes {
+		want, _ := maps.countryByCIDR(p)
+		if got, ok := packed.CountryByCIDR(p.String()); !ok || got != want {
+			t.Fatalf("CountryByCIDR(%s) = %q, %v; want %q", p, got, ok, want)
+		}
+	}
+	for _, a := range addrs {
+		want, _ := maps.lookup(a)
+		if _, got, _, ok := packed.Lookup(a); !ok || got != want {
+			t.Fatalf("Lookup(%s) = %q, %v; want %q", a, got, ok, want)
+		}
+		if _, _, _, ok := packed.Lookup(a.Next()); ok {
+			if _, ok2 := maps.lookup(a.Next()); !ok2 {
+				t.Fatalf("Lookup(%s) found a network the map layout does not have", a.Next())
+			}
+		}
+	}
+	for iso, id := range maps.idByISO {
+		r, _ := packed.RangesByCountry(iso)
+		if r.Len() != len(maps.byCountry[id]) {
+			t.Fatalf("RangesByCountry(%s) has %d networks, want %d", iso, r.Len(), len(maps.byCountry[id]))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AuthOptions returns the interceptors that authorize calls against keys.
+// The key secret is read from the x-api-key metadata, or a bearer token in
+// authorization; without one, mTLS clients are known by certificate subject.
+// lg is only called while the server runs.
+func AuthOptions(keys *auth.Keyring, lg func() *zap.Logger) []grpc.ServerOption {
+	a := &authorizer{keys: keys, lg: lg}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(a.unary),
+		grpc.ChainStreamInterceptor(a.stream),
+	}
+}
+
+type authorizer struct {
+	keys *auth.Keyring
+	lg   func() *zap.Logger
+}
+
+func (a *authorizer) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod, func(md metadata.MD) { _ = grpc.SetHeader(ctx, md) })
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authorizer) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod, func(md metadata.MD) { _ = ss.SetHeader(md) })
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorize checks a call and returns its context with the key. setHeader
+// sends the retry-after metadata of rate-limited calls.
+func (a *authorizer) authorize(ctx context.Context, fullMethod string, setHeader func(metadata.MD)) (context.Context, error) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+
+	cred := auth.Credentials{Secret: apiKey(ctx), Subject: ClientSubject(ctx)}
+	k, err := a.keys.Authorize(cred, method, time.Now())
+	auth.Observe("grpc", k, err)
+	if err == nil {
+		if k != nil {
+			a.lg().Debug("grpc request authorized", zap.String("method", fullMethod), zap.String("api_key", k.ID))
+			ctx = auth.WithKey(ctx, k)
+		}
+		return ctx, nil
+	}
+
+	fields := []zap.Field{zap.String("method", fullMethod), zap.Error(err)}
+	if k != nil {
+		fields = append(fields, zap.String("api_key", k.ID))
+	}
+	if cred.Subject != "" {
+		fields = append(fields, zap.String("client_subject", cred.Subject))
+	}
+	a.lg().Info("grpc request rejected", fields...)
+
+	var (
+		fe *auth.ForbiddenError
+		qe *auth.QuotaExceededError
+	)
+	switch {
+	case errors.As(err, &fe):
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &qe):
+		setHeader(metadata.Pairs("retry-after", strconv.Itoa(qe.RetryAfterSeconds())))
+		return ctx, status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+}
+
+// ClientSubject returns the subject of the verified client certificate of an
+// mTLS call, as in "CN=billing,O=Example"; "" without one.
+func ClientSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return info.State.VerifiedChains[0][0].Subject.String()
+}
+
+func apiKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("x-api-key"); len(v) > 0 && strings.TrimSpace(v[0]) != "" {
+		return strings.TrimSpace(v[0])
+	}
+	if v := md.Get("authorization"); len(v) > 0 {
+		scheme, token, ok := strings.Cut(strings.TrimSpace(v[0]), " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
+
+// authStream carries the authorized context into stream handlers.
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
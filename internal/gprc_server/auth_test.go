@@ -0,0 +1,155 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func testAuthorizer(t *testing.T) *authorizer {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	content := `
+keys:
+  - id: reader
+    hash: ` + auth.Hash("reader-secret") + `
+    scopes: [lookup]
+    rate: 0.001
+    burst: 1
+  - id: billing
+    subject: CN=billing,O=Example
+    scopes: [lookup]
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kr, err := auth.ReadKeyFile(path)
+	if err != nil {
+		t.Fatalf("ReadKeyFile: %v", err)
+	}
+	return &authorizer{keys: kr, lg: zap.NewNop}
+}
+
+func TestAuthorize(t *testing.T) {
+	a := testAuthorizer(t)
+
+	// Calls run in order: the reader key's single token goes to the
+	// accepted lookup, so the one after it is rate limited.
+	tests := []struct {
+		name   string
+		method string
+		md     metadata.MD
+		code   codes.Code
+		key    string
+		retry  string
+	}{
+		{name: "public without key", method: "/grpc.health.v1.Health/GetHealth", code: codes.OK},
+		{name: "no key", method: "/geocoder.v1.GeocoderService/GetIpData", code: codes.Unauthenticated},
+		{
+			name:   "unknown secret",
+			method: "/geocoder.v1.GeocoderService/GetIpData",
+			md:     metadata.Pairs("x-api-key", "nope"),
+			code:   codes.Unauthenticated,
+		},
+		{
+			name:   "wrong scope",
+			method: "/geocoder.v1.GeocoderService/GetCountryNetworks",
+			md:     metadata.Pairs("x-api-key", "reader-secret"),
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "unknown method needs admin",
+			method: "/geocoder.v1.GeocoderService/SomethingNew",
+			md:     metadata.Pairs("authorization", "Bearer reader-secret"),
+			code:   codes.PermissionDenied,
+		},
+		{
+			name:   "in scope",
+			method: "/geocoder.v1.GeocoderService/GetIpData",
+			md:     metadata.Pairs("authorization", "Bearer reader-secret"),
+			code:   codes.OK,
+			key:    "reader",
+		},
+		{
+			name:   "bucket ran dry",
+			method: "/geocoder.v1.GeocoderService/GetIpData",
+			md:     metadata.Pairs("x-api-key", "reader-secret"),
+			code:   codes.ResourceExhausted,
+			retry:  "1000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			var header metadata.MD
+			ctx, err := a.authorize(ctx, tt.method, func(md metadata.MD) { header = md })
+
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("code = %s, want %s (%v)", got, tt.code, err)
+			}
+			key := ""
+			if k, ok := auth.KeyFromContext(ctx); ok {
+				key = k.ID
+			}
+			if key != tt.key {
+				t.Errorf("key in context = %q, want %q", key, tt.key)
+			}
+			if got := header.Get("retry-after"); tt.retry == "" && len(got) > 0 ||
+				tt.retry != "" && (len(got) != 1 || got[0] != tt.retry) {
+				t.Errorf("retry-after = %q, want %q", got, tt.retry)
+			}
+		})
+	}
+}
+
+func TestAuthUnaryStopsRejectedCalls(t *testing.T) {
+	a := testAuthorizer(t)
+	called := false
+	handler := func(context.Context, any) (any, error) {
+		called = true
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/geocoder.v1.GeocoderService/GetIpData"}
+	_, err := a.unary(context.Background(), nil, info, handler)
+	if status.Code(err) != codes.Unauthenticated || called {
+		t.Errorf("call without key: code %s, handler called %v", status.Code(err), called)
+	}
+}
+
+func TestAuthorizeClientSubject(t *testing.T) {
+	a := testAuthorizer(t)
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "billing", Organization: []string{"Example"}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+
+	if got := ClientSubject(ctx); got != "CN=billing,O=Example" {
+		t.Fatalf("ClientSubject = %q", got)
+	}
+	ctx, err := a.authorize(ctx, "/geocoder.v1.GeocoderService/GetIpData", func(metadata.MD) {})
+	if err != nil {
+		t.Fatalf("authorize: %v", err)
+	}
+	if k, ok := auth.KeyFromContext(ctx); !ok || k.ID != "billing" {
+		t.Errorf("key in context = %v, want billing", k)
+	}
+
+	if got := ClientSubject(context.Background()); got != "" {
+		t.Errorf("ClientSubject without a peer = %q", got)
+	}
+}
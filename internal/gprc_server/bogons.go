@@ -0,0 +1,25 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) GetBogons(ctx context.Context, req *geocoderv1.GetBogonsRequest) (*geocoderv1.GetBogonsResponse, error) {
+	res, err := h.api.GetBogons(ctx, req.GetFamily(), req.GetFormat(), req.GetName())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	networks := make([]string, len(res.Networks))
+	for i, p := range res.Networks {
+		networks[i] = p.String()
+	}
+	return &geocoderv1.GetBogonsResponse{
+		Family:   res.Family,
+		Networks: networks,
+		Format:   res.Format,
+		Export:   string(res.Export),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) GetRangeBreakdown(ctx context.Context, req *geocoderv1.GetRangeBreakdownRequest) (*geocoderv1.GetRangeBreakdownResponse, error) {
+	items, err := h.api.GetRangeBreakdown(ctx, req.GetRanges())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	out := make([]*geocoderv1.RangeBreakdown, 0, len(items))
+	for _, it := range items {
+		item := &geocoderv1.RangeBreakdown{
+			Query:                it.Query,
+			First:                it.Range.First.String(),
+			Last:                 it.Range.Last.String(),
+			Addresses:            it.Addresses.String(),
+			Countries:            make([]*geocoderv1.CountryShare, 0, len(it.Countries)),
+			Unallocated:          make([]string, 0, len(it.Unallocated)),
+			UnallocatedAddresses: it.UnallocatedAddresses.String(),
+			UnallocatedPercent:   it.UnallocatedPercent,
+		}
+		for _, c := range it.Countries {
+			networks := make([]string, len(c.Networks))
+			for i, p := range c.Networks {
+				networks[i] = p.String()
+			}
+			item.Countries = append(item.Countries, &geocoderv1.CountryShare{
+				Code:      c.Code,
+				Networks:  networks,
+				Addresses: c.Addresses.String(),
+				Percent:   c.Percent,
+			})
+		}
+		for _, r := range it.Unallocated {
+			item.Unallocated = append(item.Unallocated, r.String())
+		}
+		out = append(out, item)
+	}
+	return &geocoderv1.GetRangeBreakdownResponse{Items: out}, nil
+}
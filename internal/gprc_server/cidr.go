@@ -0,0 +1,55 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) GetCidrData(ctx context.Context, req *geocoderv1.GetCidrDataRequest) (*geocoderv1.GetCidrDataResponse, error) {
+	size := int(req.GetSize())
+	if size == 0 {
+		size = 1000 // default
+	}
+
+	items, err := h.api.GetCidrData(ctx, req.GetCidrs(), int(req.GetPage()), size)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	out := make([]*geocoderv1.CidrData, 0, len(items))
+	for _, it := range items {
+		item := &geocoderv1.CidrData{
+			Cidr:         it.CIDR.String(),
+			Supernets:    toProtoNetworks(it.Supernets),
+			Subnets:      toProtoNetworks(it.Subnets),
+			TotalSubnets: int64(it.TotalSubnets),
+			TotalPages:   int64(it.TotalPages),
+			Page:         int64(it.Page),
+			Size:         int64(it.Size),
+		}
+		if it.Exact != nil {
+			item.Exact = toProtoNetwork(*it.Exact)
+		}
+		out = append(out, item)
+	}
+	return &geocoderv1.GetCidrDataResponse{Items: out}, nil
+}
+
+func toProtoNetworks(in []geocoder_api.NetworkData) []*geocoderv1.NetworkData {
+	out := make([]*geocoderv1.NetworkData, len(in))
+	for i, n := range in {
+		out[i] = toProtoNetwork(n)
+	}
+	return out
+}
+
+func toProtoNetwork(n geocoder_api.NetworkData) *geocoderv1.NetworkData {
+	return &geocoderv1.NetworkData{
+		Network:    n.Network.String(),
+		Code:       n.Code,
+		Source:     n.Source,
+		Provenance: n.Provenance,
+	}
+}
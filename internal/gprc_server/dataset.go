@@ -0,0 +1,39 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) GetDataset(ctx context.Context, _ *emptypb.Empty) (*geocoderv1.GetDatasetResponse, error) {
+	md, err := h.api.GetDataset(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	sources := make([]*geocoderv1.SourceInfo, 0, len(md.Sources))
+	for _, src := range md.Sources {
+		sources = append(sources, &geocoderv1.SourceInfo{
+			Name:     src.Name,
+			Dataset:  toProtoDatasetInfo(src.Dataset),
+			Networks: int64(src.Networks),
+		})
+	}
+
+	return &geocoderv1.GetDatasetResponse{
+		Dataset: toProtoDatasetInfo(md.Dataset),
+		Sources: sources,
+	}, nil
+}
+
+func toProtoDatasetInfo(ds geocoder_api.DatasetInfo) *geocoderv1.DatasetInfo {
+	return &geocoderv1.DatasetInfo{
+		Type:        ds.Type,
+		BuildEpoch:  ds.BuildTime.Unix(),
+		Description: ds.Description,
+	}
+}
@@ -0,0 +1,68 @@
+package grpc_server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) ExplainIp(ctx context.Context, req *geocoderv1.ExplainIpRequest) (*geocoderv1.ExplainIpResponse, error) {
+	ex, err := h.api.ExplainIp(ctx, req.GetIp())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	steps := make([]*geocoderv1.NormalizeStep, 0, len(ex.Steps))
+	for _, st := range ex.Steps {
+		steps = append(steps, &geocoderv1.NormalizeStep{
+			Field:      st.Field,
+			Raw:        st.Raw,
+			Normalized: st.Normalized,
+			Selected:   st.Selected,
+		})
+	}
+
+	resp := &geocoderv1.ExplainIpResponse{
+		Ip:            ex.IP,
+		Address:       ex.Address.String(),
+		Alias:         ex.Alias,
+		AliasApplied:  ex.AliasApplied,
+		LookupAddress: ex.LookupAddress.String(),
+		Found:         ex.Found,
+		DatabaseCode:  ex.DatabaseCode,
+		Normalization: steps,
+		Code:          ex.Code,
+		Source:        ex.Source,
+		Dataset:       toProtoDatasetInfo(ex.Dataset),
+	}
+	if ex.EmbeddedIPv4.IsValid() {
+		resp.EmbeddedIpv4 = ex.EmbeddedIPv4.String()
+	}
+	if ex.Network.IsValid() {
+		resp.Network = ex.Network.String()
+	}
+	if ex.DatabaseNetwork.IsValid() {
+		resp.DatabaseNetwork = ex.DatabaseNetwork.String()
+	}
+	if o := ex.Override; o != nil {
+		resp.Override = &geocoderv1.OverrideInfo{
+			Network: o.Network.String(),
+			Code:    o.Code,
+			Comment: o.Comment,
+			Origin:  o.Origin,
+		}
+		if !o.Expires.IsZero() {
+			resp.Override.Expires = o.Expires.Unix()
+		}
+	}
+	if ex.Record != nil {
+		b, err := json.Marshal(ex.Record)
+		if err != nil {
+			return nil, toGRPCError(err)
+		}
+		resp.RecordJson = string(b)
+	}
+
+	return resp, nil
+}
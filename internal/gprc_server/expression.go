@@ -0,0 +1,25 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) EvaluateExpression(ctx context.Context, req *geocoderv1.EvaluateExpressionRequest) (*geocoderv1.EvaluateExpressionResponse, error) {
+	res, err := h.api.EvaluateExpression(ctx, req.GetExpression(), req.GetFormat(), req.GetName())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	networks := make([]string, len(res.Networks))
+	for i, p := range res.Networks {
+		networks[i] = p.String()
+	}
+	return &geocoderv1.EvaluateExpressionResponse{
+		Expression: res.Expression,
+		Networks:   networks,
+		Format:     res.Format,
+		Export:     string(res.Export),
+	}, nil
+}
@@ -0,0 +1,27 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *Handler) GetGroups(ctx context.Context, _ *emptypb.Empty) (*geocoderv1.GetGroupsResponse, error) {
+	groups, err := h.api.GetGroups(ctx)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	out := make([]*geocoderv1.CountryGroup, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, &geocoderv1.CountryGroup{
+			Id:      g.ID,
+			Name:    g.Name,
+			Kind:    g.Kind,
+			Members: g.Members,
+		})
+	}
+	return &geocoderv1.GetGroupsResponse{Groups: out}, nil
+}
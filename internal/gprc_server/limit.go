@@ -0,0 +1,90 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+	"github.com/Elessarov1/geocoder-go/internal/ratelimit"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// LimitOptions returns the interceptors that apply l to heavy calls. Clients
+// are told apart by API key, or else by peer address; streams hold their
+// in-flight slot until they end. lg is only called while the server runs.
+func LimitOptions(l *ratelimit.Limiter, lg func() *zap.Logger) []grpc.ServerOption {
+	g := &limitGuard{limiter: l, lg: lg}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(g.unary),
+		grpc.ChainStreamInterceptor(g.stream),
+	}
+}
+
+type limitGuard struct {
+	limiter *ratelimit.Limiter
+	lg      func() *zap.Logger
+}
+
+func (g *limitGuard) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	release, err := g.acquire(ctx, info.FullMethod, func(md metadata.MD) { _ = grpc.SetHeader(ctx, md) })
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+func (g *limitGuard) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := g.acquire(ss.Context(), info.FullMethod, func(md metadata.MD) { _ = ss.SetHeader(md) })
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+func (g *limitGuard) acquire(ctx context.Context, fullMethod string, setHeader func(metadata.MD)) (func(), error) {
+	if !ratelimit.Heavy(fullMethod[strings.LastIndex(fullMethod, "/")+1:]) {
+		return func() {}, nil
+	}
+
+	client := peerID(ctx)
+	release, err := g.limiter.Acquire(client, time.Now())
+	if err == nil {
+		return release, nil
+	}
+
+	ratelimit.Observe("grpc", err)
+	g.lg().Info("grpc request limited", zap.String("method", fullMethod), zap.String("client", client), zap.Error(err))
+
+	var le *ratelimit.LimitError
+	if errors.As(err, &le) {
+		setHeader(metadata.Pairs("retry-after", strconv.Itoa(le.RetryAfterSeconds())))
+	}
+	return nil, status.Error(codes.ResourceExhausted, err.Error())
+}
+
+func peerID(ctx context.Context) string {
+	if k, ok := auth.KeyFromContext(ctx); ok {
+		return "key " + k.ID
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "-"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
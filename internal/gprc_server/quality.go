@@ -0,0 +1,67 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+	"github.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1"
+)
+
+func (h *Handler) GetQualityReport(ctx context.Context, req *geocoderv1.GetQualityReportRequest) (*geocoderv1.QualityReport, error) {
+	rep, err := h.api.GetQualityReport(ctx, int(req.GetLimit()), req.GetThreshold(), int(req.GetMinNetworks()))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	out := &geocoderv1.QualityReport{
+		RegisteredDiffers:      toProtoNetworkIssues(rep.RegisteredDiffers),
+		TotalRegisteredDiffers: int64(rep.TotalRegisteredDiffers),
+		Fallbacks:              toProtoNetworkIssues(rep.Fallbacks),
+		TotalFallbacks:         int64(rep.TotalFallbacks),
+		AliasConflicts:         make([]*geocoderv1.AliasConflict, 0, len(rep.AliasConflicts)),
+		TotalAliasConflicts:    int64(rep.TotalAliasConflicts),
+		HasPrevious:            rep.HasPrevious,
+		Changes:                make([]*geocoderv1.CountryChange, 0, len(rep.Changes)),
+		TotalChanges:           int64(rep.TotalChanges),
+	}
+	for _, c := range rep.AliasConflicts {
+		out.AliasConflicts = append(out.AliasConflicts, &geocoderv1.AliasConflict{
+			Network:     c.Network.String(),
+			Code:        c.Code,
+			Alias:       c.Alias,
+			Ipv4Network: c.IPv4.String(),
+			Ipv4Code:    c.IPv4Code,
+		})
+	}
+	for _, c := range rep.Changes {
+		out.Changes = append(out.Changes, &geocoderv1.CountryChange{
+			Code:    c.Code,
+			Before:  toProtoCountryCount(c.Before),
+			After:   toProtoCountryCount(c.After),
+			Percent: c.Percent,
+		})
+	}
+	return out, nil
+}
+
+func toProtoNetworkIssues(in []geocoder_api.NetworkIssue) []*geocoderv1.NetworkIssue {
+	out := make([]*geocoderv1.NetworkIssue, 0, len(in))
+	for _, it := range in {
+		out = append(out, &geocoderv1.NetworkIssue{
+			Network:        it.Network.String(),
+			Code:           it.Code,
+			RegisteredCode: it.RegisteredCode,
+			Provenance:     it.Provenance,
+		})
+	}
+	return out
+}
+
+func toProtoCountryCount(c geoip.CountryCount) *geocoderv1.CountryCount {
+	return &geocoderv1.CountryCount{
+		Networks:      int32(c.Networks),
+		Ipv4Addresses: c.IPv4Addresses,
+		Ipv6Slash64S:  c.IPv6Slash64s,
+	}
+}
@@ -38,6 +38,10 @@ func toGRPCError(err error) error {
 	if errors.As(err, &nf) {
 		return status.Error(codes.NotFound, nf.Error())
 	}
+	var fb *geocoder_api.ForbiddenError
+	if errors.As(err, &fb) {
+		return status.Error(codes.PermissionDenied, fb.Error())
+	}
 	return status.Error(codes.Internal, err.Error())
 }
 
@@ -61,13 +65,49 @@ func (h *Handler) GetCountries(ctx context.Context, _ *emptypb.Empty) (*geocoder
 	out := make([]*geocoderv1.CountryRangeData, 0, len(items))
 	for _, it := range items {
 		out = append(out, &geocoderv1.CountryRangeData{
-			Code:        it.Code,
-			RangesCount: int32(it.RangesCount),
+			Code:          it.Code,
+			RangesCount:   int32(it.RangesCount),
+			Ipv4Addresses: it.IPv4Addresses,
+			Ipv6Slash64S:  it.IPv6Slash64s,
+			Ipv4Percent:   it.IPv4Percent,
+			Ipv6Percent:   it.IPv6Percent,
 		})
 	}
 	return &geocoderv1.GetCountriesResponse{Countries: out}, nil
 }
 
+func (h *Handler) GetCountry(ctx context.Context, req *geocoderv1.GetCountryRequest) (*geocoderv1.CountryInfo, error) {
+	c, err := h.api.GetCountry(ctx, req.GetCode())
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+
+	return &geocoderv1.CountryInfo{
+		Code:      c.Code,
+		Alpha3:    c.Alpha3,
+		Numeric:   c.Numeric,
+		Name:      c.Name,
+		Continent: c.Continent,
+		Region:    c.Region,
+		Ipv4:      toProtoAddressSpace(c.IPv4),
+		Ipv6:      toProtoAddressSpace(c.IPv6),
+	}, nil
+}
+
+func toProtoAddressSpace(s geocoder_api.AddressSpace) *geocoderv1.AddressSpace {
+	out := &geocoderv1.AddressSpace{
+		Networks:      int32(s.Networks),
+		Addresses:     s.Addresses.String(),
+		Slash64S:      s.Slash64s,
+		Percent:       s.Percent,
+		PrefixLengths: make([]*geocoderv1.PrefixLengthCount, len(s.Prefixes)),
+	}
+	for i, p := range s.Prefixes {
+		out.PrefixLengths[i] = &geocoderv1.PrefixLengthCount{Bits: int32(p.Bits), Networks: int32(p.Networks)}
+	}
+	return out
+}
+
 func (h *Handler) GetIpData(ctx context.Context, req *geocoderv1.GetIpDataRequest) (*geocoderv1.GetIpDataResponse, error) {
 	ips := make([]string, 0, len(req.GetIps()))
 	for _, ip := range req.GetIps() {
@@ -76,55 +116,80 @@ func (h *Handler) GetIpData(ctx context.Context, req *geocoderv1.GetIpDataReques
 		}
 	}
 
-	items, err := h.api.GetIpData(ctx, ips)
+	items, err := h.api.GetIpData(ctx, ips, req.GetBasis())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
 	out := make([]*geocoderv1.GeoIpData, 0, len(items))
 	for _, it := range items {
-		out = append(out, &geocoderv1.GeoIpData{
-			Ip:          it.IP,
-			Code:        it.Code,
-			CountryName: it.CountryName,
-		})
+		item := &geocoderv1.GeoIpData{
+			Ip:                       it.IP,
+			NormalizedIp:             it.NormalizedIP.String(),
+			Code:                     it.Code,
+			CountryName:              it.CountryName,
+			Source:                   it.Source,
+			RegisteredCode:           it.RegisteredCode,
+			RegisteredCountryDiffers: it.RegisteredDiffers,
+			AddressClass:             it.AddressClass,
+			Bogon:                    it.Bogon,
+		}
+		if it.Network.IsValid() {
+			item.Network = it.Network.String()
+		}
+		if it.Special != nil {
+			item.Special = &geocoderv1.SpecialBlock{
+				Network: it.Special.Network.String(),
+				Name:    it.Special.Name,
+				Rfc:     it.Special.RFC,
+			}
+		}
+		if it.Alias != "" {
+			item.Alias, item.LookupIp = it.Alias, it.LookupIP.String()
+		}
+		if req.GetProvenance() {
+			item.Provenance = it.Provenance
+		}
+		out = append(out, item)
 	}
 	return &geocoderv1.GetIpDataResponse{Items: out}, nil
 }
 
 func (h *Handler) GetCountryNetworks(ctx context.Context, req *geocoderv1.GetCountryNetworksRequest) (*geocoderv1.GetCountryNetworksResponse, error) {
-	items, err := h.api.GetCountryNetworks(ctx, req.GetIsoCodes())
+	items, err := h.api.GetCountryNetworks(ctx, req.GetIsoCodes(), req.GetBasis(), req.GetProvenance())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
 	out := make([]*geocoderv1.IsoCodeNetworks, 0, len(items))
 	for _, it := range items {
-		nets := make([]string, len(it.Networks))
-		for i, p := range it.Networks {
+		nets := make([]string, it.Networks.Len())
+		for i, p := range it.Networks.All() {
 			nets[i] = p.String()
 		}
 		out = append(out, &geocoderv1.IsoCodeNetworks{
-			Code:     it.Code,
-			Networks: nets,
+			Code:       it.Code,
+			Networks:   nets,
+			Provenance: it.Provenance,
 		})
 	}
 	return &geocoderv1.GetCountryNetworksResponse{Items: out}, nil
 }
 
 func (h *Handler) GetCountryNetworksPaged(ctx context.Context, req *geocoderv1.GetCountryNetworksPagedRequest) (*geocoderv1.PageDataString, error) {
-	pd, err := h.api.GetCountryNetworksPaged(ctx, req.GetIsoCode(), int(req.GetPage()), int(req.GetSize()))
+	pd, err := h.api.GetCountryNetworksPaged(ctx, req.GetIsoCode(), int(req.GetPage()), int(req.GetSize()), req.GetBasis(), req.GetProvenance())
 	if err != nil {
 		return nil, toGRPCError(err)
 	}
 
-	content := make([]string, len(pd.Content))
-	for i, p := range pd.Content {
+	content := make([]string, pd.Content.Len())
+	for i, p := range pd.Content.All() {
 		content[i] = p.String()
 	}
 
 	return &geocoderv1.PageDataString{
 		Content:       content,
+		Provenance:    pd.Provenance,
 		TotalElements: int64(pd.TotalElements),
 		TotalPages:    int64(pd.TotalPages),
 		Page:          int64(pd.Page),
@@ -147,20 +212,20 @@ func (h *Handler) GetCountryNetworksStream(
 	if chunkSize <= 0 {
 		chunkSize = 5000 // default
 	}
-	if chunkSize > 100000 {
-		chunkSize = 100000
+	if chunkSize > geocoder_api.MaxPageSize {
+		chunkSize = geocoder_api.MaxPageSize
 	}
 
 	for _, code := range isoCodes {
 		page := 0
 		for {
-			pd, err := h.api.GetCountryNetworksPaged(ctx, code, page, chunkSize)
+			pd, err := h.api.GetCountryNetworksPaged(ctx, code, page, chunkSize, req.GetBasis(), req.GetProvenance())
 			if err != nil {
 				return toGRPCError(err)
 			}
 
-			nets := make([]string, len(pd.Content))
-			for i, p := range pd.Content {
+			nets := make([]string, pd.Content.Len())
+			for i, p := range pd.Content.All() {
 				nets[i] = p.String()
 			}
 
@@ -180,6 +245,7 @@ func (h *Handler) GetCountryNetworksStream(
 				Page:       int32(page),
 				TotalPages: int32(totalPages),
 				Last:       last,
+				Provenance: pd.Provenance,
 			}); err != nil {
 				return err
 			}
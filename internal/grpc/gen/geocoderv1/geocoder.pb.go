@@ -75,9 +75,15 @@ func (x *Health) GetVersion() string {
 }
 
 type CountryRangeData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	RangesCount   int32                  `protobuf:"varint,2,opt,name=ranges_count,json=rangesCount,proto3" json:"ranges_count,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	RangesCount int32                  `protobuf:"varint,2,opt,name=ranges_count,json=rangesCount,proto3" json:"ranges_count,omitempty"` // networks of any length
+	// Address space the country answers for; where networks nest the most
+	// specific one wins, as in lookups.
+	Ipv4Addresses uint64  `protobuf:"varint,3,opt,name=ipv4_addresses,json=ipv4Addresses,proto3" json:"ipv4_addresses,omitempty"`
+	Ipv6Slash64S  uint64  `protobuf:"varint,4,opt,name=ipv6_slash64s,json=ipv6Slash64s,proto3" json:"ipv6_slash64s,omitempty"` // /64s, rounded down
+	Ipv4Percent   float64 `protobuf:"fixed64,5,opt,name=ipv4_percent,json=ipv4Percent,proto3" json:"ipv4_percent,omitempty"`   // of the dataset's IPv4 space
+	Ipv6Percent   float64 `protobuf:"fixed64,6,opt,name=ipv6_percent,json=ipv6Percent,proto3" json:"ipv6_percent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +132,34 @@ func (x *CountryRangeData) GetRangesCount() int32 {
 	return 0
 }
 
+func (x *CountryRangeData) GetIpv4Addresses() uint64 {
+	if x != nil {
+		return x.Ipv4Addresses
+	}
+	return 0
+}
+
+func (x *CountryRangeData) GetIpv6Slash64S() uint64 {
+	if x != nil {
+		return x.Ipv6Slash64S
+	}
+	return 0
+}
+
+func (x *CountryRangeData) GetIpv4Percent() float64 {
+	if x != nil {
+		return x.Ipv4Percent
+	}
+	return 0
+}
+
+func (x *CountryRangeData) GetIpv6Percent() float64 {
+	if x != nil {
+		return x.Ipv6Percent
+	}
+	return 0
+}
+
 type GetCountriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Countries     []*CountryRangeData    `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
@@ -170,27 +204,27 @@ func (x *GetCountriesResponse) GetCountries() []*CountryRangeData {
 	return nil
 }
 
-type IpPayload struct {
+type GetCountryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // ISO2 or ISO3
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IpPayload) Reset() {
-	*x = IpPayload{}
+func (x *GetCountryRequest) Reset() {
+	*x = GetCountryRequest{}
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IpPayload) String() string {
+func (x *GetCountryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpPayload) ProtoMessage() {}
+func (*GetCountryRequest) ProtoMessage() {}
 
-func (x *IpPayload) ProtoReflect() protoreflect.Message {
+func (x *GetCountryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -202,41 +236,43 @@ func (x *IpPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpPayload.ProtoReflect.Descriptor instead.
-func (*IpPayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCountryRequest.ProtoReflect.Descriptor instead.
+func (*GetCountryRequest) Descriptor() ([]byte, []int) {
 	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IpPayload) GetIp() string {
+func (x *GetCountryRequest) GetCode() string {
 	if x != nil {
-		return x.Ip
+		return x.Code
 	}
 	return ""
 }
 
-type GeoIpData struct {
+type AddressSpace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	CountryName   string                 `protobuf:"bytes,3,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	Networks      int32                  `protobuf:"varint,1,opt,name=networks,proto3" json:"networks,omitempty"`
+	Addresses     string                 `protobuf:"bytes,2,opt,name=addresses,proto3" json:"addresses,omitempty"`                              // exact decimal count; nested networks go to the most specific one
+	Slash64S      uint64                 `protobuf:"varint,3,opt,name=slash64s,proto3" json:"slash64s,omitempty"`                               // IPv6 only: addresses in /64s, rounded down
+	Percent       float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`                                // of the family's space in the dataset
+	PrefixLengths []*PrefixLengthCount   `protobuf:"bytes,5,rep,name=prefix_lengths,json=prefixLengths,proto3" json:"prefix_lengths,omitempty"` // shortest first, empty lengths left out
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GeoIpData) Reset() {
-	*x = GeoIpData{}
+func (x *AddressSpace) Reset() {
+	*x = AddressSpace{}
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeoIpData) String() string {
+func (x *AddressSpace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeoIpData) ProtoMessage() {}
+func (*AddressSpace) ProtoMessage() {}
 
-func (x *GeoIpData) ProtoReflect() protoreflect.Message {
+func (x *AddressSpace) ProtoReflect() protoreflect.Message {
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -248,53 +284,68 @@ func (x *GeoIpData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeoIpData.ProtoReflect.Descriptor instead.
-func (*GeoIpData) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddressSpace.ProtoReflect.Descriptor instead.
+func (*AddressSpace) Descriptor() ([]byte, []int) {
 	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GeoIpData) GetIp() string {
+func (x *AddressSpace) GetNetworks() int32 {
 	if x != nil {
-		return x.Ip
+		return x.Networks
 	}
-	return ""
+	return 0
 }
 
-func (x *GeoIpData) GetCode() string {
+func (x *AddressSpace) GetAddresses() string {
 	if x != nil {
-		return x.Code
+		return x.Addresses
 	}
 	return ""
 }
 
-func (x *GeoIpData) GetCountryName() string {
+func (x *AddressSpace) GetSlash64S() uint64 {
 	if x != nil {
-		return x.CountryName
+		return x.Slash64S
 	}
-	return ""
+	return 0
 }
 
-type GetIpDataRequest struct {
+func (x *AddressSpace) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *AddressSpace) GetPrefixLengths() []*PrefixLengthCount {
+	if x != nil {
+		return x.PrefixLengths
+	}
+	return nil
+}
+
+type PrefixLengthCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ips           []*IpPayload           `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
+	Bits          int32                  `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"`
+	Networks      int32                  `protobuf:"varint,2,opt,name=networks,proto3" json:"networks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIpDataRequest) Reset() {
-	*x = GetIpDataRequest{}
+func (x *PrefixLengthCount) Reset() {
+	*x = PrefixLengthCount{}
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIpDataRequest) String() string {
+func (x *PrefixLengthCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIpDataRequest) ProtoMessage() {}
+func (*PrefixLengthCount) ProtoMessage() {}
 
-func (x *GetIpDataRequest) ProtoReflect() protoreflect.Message {
+func (x *PrefixLengthCount) ProtoReflect() protoreflect.Message {
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,39 +357,53 @@ func (x *GetIpDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIpDataRequest.ProtoReflect.Descriptor instead.
-func (*GetIpDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PrefixLengthCount.ProtoReflect.Descriptor instead.
+func (*PrefixLengthCount) Descriptor() ([]byte, []int) {
 	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetIpDataRequest) GetIps() []*IpPayload {
+func (x *PrefixLengthCount) GetBits() int32 {
 	if x != nil {
-		return x.Ips
+		return x.Bits
 	}
-	return nil
+	return 0
 }
 
-type GetIpDataResponse struct {
+func (x *PrefixLengthCount) GetNetworks() int32 {
+	if x != nil {
+		return x.Networks
+	}
+	return 0
+}
+
+type CountryInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*GeoIpData           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`     // ISO2
+	Alpha3        string                 `protobuf:"bytes,2,opt,name=alpha3,proto3" json:"alpha3,omitempty"` // ISO fields are empty for codes outside ISO 3166 (ZZ)
+	Numeric       string                 `protobuf:"bytes,3,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Continent     string                 `protobuf:"bytes,5,opt,name=continent,proto3" json:"continent,omitempty"`
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"` // UN M49 sub-region
+	Ipv4          *AddressSpace          `protobuf:"bytes,7,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ipv6          *AddressSpace          `protobuf:"bytes,8,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIpDataResponse) Reset() {
-	*x = GetIpDataResponse{}
+func (x *CountryInfo) Reset() {
+	*x = CountryInfo{}
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIpDataResponse) String() string {
+func (x *CountryInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIpDataResponse) ProtoMessage() {}
+func (*CountryInfo) ProtoMessage() {}
 
-func (x *GetIpDataResponse) ProtoReflect() protoreflect.Message {
+func (x *CountryInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_geocoder_v1_geocoder_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -350,92 +415,89 @@ func (x *GetIpDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIpDataResponse.ProtoReflect.Descriptor instead.
-func (*GetIpDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountryInfo.ProtoReflect.Descriptor instead.
+func (*CountryInfo) Descriptor() ([]byte, []int) {
 	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetIpDataResponse) GetItems() []*GeoIpData {
+func (x *CountryInfo) GetCode() string {
 	if x != nil {
-		return x.Items
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type IsoCodeNetworks struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"` // "1.2.3.0/24"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CountryInfo) GetAlpha3() string {
+	if x != nil {
+		return x.Alpha3
+	}
+	return ""
 }
 
-func (x *IsoCodeNetworks) Reset() {
-	*x = IsoCodeNetworks{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CountryInfo) GetNumeric() string {
+	if x != nil {
+		return x.Numeric
+	}
+	return ""
 }
 
-func (x *IsoCodeNetworks) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CountryInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*IsoCodeNetworks) ProtoMessage() {}
-
-func (x *IsoCodeNetworks) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[7]
+func (x *CountryInfo) GetContinent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Continent
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use IsoCodeNetworks.ProtoReflect.Descriptor instead.
-func (*IsoCodeNetworks) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{7}
+func (x *CountryInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
 }
 
-func (x *IsoCodeNetworks) GetCode() string {
+func (x *CountryInfo) GetIpv4() *AddressSpace {
 	if x != nil {
-		return x.Code
+		return x.Ipv4
 	}
-	return ""
+	return nil
 }
 
-func (x *IsoCodeNetworks) GetNetworks() []string {
+func (x *CountryInfo) GetIpv6() *AddressSpace {
 	if x != nil {
-		return x.Networks
+		return x.Ipv6
 	}
 	return nil
 }
 
-type GetCountryNetworksRequest struct {
+type IpPayload struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsoCodes      []string               `protobuf:"bytes,1,rep,name=iso_codes,json=isoCodes,proto3" json:"iso_codes,omitempty"` // ["RU","US"]
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"` // also "1.2.3.4:5678", "[2001:db8::1]:443", "fe80::1%eth0", "16909060" or a CIDR, as configured
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCountryNetworksRequest) Reset() {
-	*x = GetCountryNetworksRequest{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[8]
+func (x *IpPayload) Reset() {
+	*x = IpPayload{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCountryNetworksRequest) String() string {
+func (x *IpPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCountryNetworksRequest) ProtoMessage() {}
+func (*IpPayload) ProtoMessage() {}
 
-func (x *GetCountryNetworksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[8]
+func (x *IpPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,40 +508,54 @@ func (x *GetCountryNetworksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCountryNetworksRequest.ProtoReflect.Descriptor instead.
-func (*GetCountryNetworksRequest) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use IpPayload.ProtoReflect.Descriptor instead.
+func (*IpPayload) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetCountryNetworksRequest) GetIsoCodes() []string {
+func (x *IpPayload) GetIp() string {
 	if x != nil {
-		return x.IsoCodes
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type GetCountryNetworksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*IsoCodeNetworks     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+type GeoIpData struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Ip                       string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`                                          // as given
+	NormalizedIp             string                 `protobuf:"bytes,14,opt,name=normalized_ip,json=normalizedIp,proto3" json:"normalized_ip,omitempty"` // as read: port, brackets and zone dropped
+	Code                     string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	CountryName              string                 `protobuf:"bytes,3,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	Network                  string                 `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`                                     // matched database network, "" if not found
+	Source                   string                 `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`                                       // country | registered_country | represented_country | fallback | override
+	RegisteredCode           string                 `protobuf:"bytes,6,opt,name=registered_code,json=registeredCode,proto3" json:"registered_code,omitempty"` // registered_country ISO2, "" if absent
+	RegisteredCountryDiffers bool                   `protobuf:"varint,7,opt,name=registered_country_differs,json=registeredCountryDiffers,proto3" json:"registered_country_differs,omitempty"`
+	Provenance               string                 `protobuf:"bytes,8,opt,name=provenance,proto3" json:"provenance,omitempty"`                         // mmdb | rir | csv | overrides; set if requested
+	AddressClass             string                 `protobuf:"bytes,9,opt,name=address_class,json=addressClass,proto3" json:"address_class,omitempty"` // none | private | loopback | link_local | cgnat | documentation | multicast | reserved | special
+	// address_class, special and bogon describe lookup_ip when an alias was unwrapped.
+	Special       *SpecialBlock `protobuf:"bytes,10,opt,name=special,proto3" json:"special,omitempty"`                   // IANA special-purpose entry, unset for ordinary space
+	Bogon         bool          `protobuf:"varint,11,opt,name=bogon,proto3" json:"bogon,omitempty"`                      // not globally reachable
+	Alias         string        `protobuf:"bytes,12,opt,name=alias,proto3" json:"alias,omitempty"`                       // ipv4_mapped | 6to4 | teredo if looked up by the embedded IPv4, else ""
+	LookupIp      string        `protobuf:"bytes,13,opt,name=lookup_ip,json=lookupIp,proto3" json:"lookup_ip,omitempty"` // the embedded IPv4 address looked up, set with alias
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCountryNetworksResponse) Reset() {
-	*x = GetCountryNetworksResponse{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[9]
+func (x *GeoIpData) Reset() {
+	*x = GeoIpData{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCountryNetworksResponse) String() string {
+func (x *GeoIpData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCountryNetworksResponse) ProtoMessage() {}
+func (*GeoIpData) ProtoMessage() {}
 
-func (x *GetCountryNetworksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[9]
+func (x *GeoIpData) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,118 +566,2221 @@ func (x *GetCountryNetworksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCountryNetworksResponse.ProtoReflect.Descriptor instead.
-func (*GetCountryNetworksResponse) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GeoIpData.ProtoReflect.Descriptor instead.
+func (*GeoIpData) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCountryNetworksResponse) GetItems() []*IsoCodeNetworks {
+func (x *GeoIpData) GetIp() string {
 	if x != nil {
-		return x.Items
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type PageDataString struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       []string               `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`
-	TotalElements int64                  `protobuf:"varint,2,opt,name=total_elements,json=totalElements,proto3" json:"total_elements,omitempty"`
-	TotalPages    int64                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	Page          int64                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GeoIpData) GetNormalizedIp() string {
+	if x != nil {
+		return x.NormalizedIp
+	}
+	return ""
 }
 
-func (x *PageDataString) Reset() {
-	*x = PageDataString{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GeoIpData) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-func (x *PageDataString) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GeoIpData) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
 }
 
-func (*PageDataString) ProtoMessage() {}
-
-func (x *PageDataString) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[10]
+func (x *GeoIpData) GetNetwork() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Network
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PageDataString.ProtoReflect.Descriptor instead.
-func (*PageDataString) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{10}
+func (x *GeoIpData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
 }
 
-func (x *PageDataString) GetContent() []string {
+func (x *GeoIpData) GetRegisteredCode() string {
 	if x != nil {
-		return x.Content
+		return x.RegisteredCode
 	}
-	return nil
+	return ""
 }
 
-func (x *PageDataString) GetTotalElements() int64 {
+func (x *GeoIpData) GetRegisteredCountryDiffers() bool {
 	if x != nil {
-		return x.TotalElements
+		return x.RegisteredCountryDiffers
 	}
-	return 0
+	return false
 }
 
-func (x *PageDataString) GetTotalPages() int64 {
+func (x *GeoIpData) GetProvenance() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Provenance
+	}
+	return ""
+}
+
+func (x *GeoIpData) GetAddressClass() string {
+	if x != nil {
+		return x.AddressClass
+	}
+	return ""
+}
+
+func (x *GeoIpData) GetSpecial() *SpecialBlock {
+	if x != nil {
+		return x.Special
+	}
+	return nil
+}
+
+func (x *GeoIpData) GetBogon() bool {
+	if x != nil {
+		return x.Bogon
+	}
+	return false
+}
+
+func (x *GeoIpData) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *GeoIpData) GetLookupIp() string {
+	if x != nil {
+		return x.LookupIp
+	}
+	return ""
+}
+
+type SpecialBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // "Private-Use"
+	Rfc           string                 `protobuf:"bytes,3,opt,name=rfc,proto3" json:"rfc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpecialBlock) Reset() {
+	*x = SpecialBlock{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpecialBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpecialBlock) ProtoMessage() {}
+
+func (x *SpecialBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpecialBlock.ProtoReflect.Descriptor instead.
+func (*SpecialBlock) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SpecialBlock) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SpecialBlock) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpecialBlock) GetRfc() string {
+	if x != nil {
+		return x.Rfc
+	}
+	return ""
+}
+
+type GetIpDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ips           []*IpPayload           `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
+	Provenance    bool                   `protobuf:"varint,2,opt,name=provenance,proto3" json:"provenance,omitempty"` // fill GeoIpData.provenance
+	Basis         string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"`            // located (default) | registered: answer with registered_country
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIpDataRequest) Reset() {
+	*x = GetIpDataRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIpDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIpDataRequest) ProtoMessage() {}
+
+func (x *GetIpDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIpDataRequest.ProtoReflect.Descriptor instead.
+func (*GetIpDataRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetIpDataRequest) GetIps() []*IpPayload {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+func (x *GetIpDataRequest) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+func (x *GetIpDataRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type GetIpDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*GeoIpData           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIpDataResponse) Reset() {
+	*x = GetIpDataResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIpDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIpDataResponse) ProtoMessage() {}
+
+func (x *GetIpDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIpDataResponse.ProtoReflect.Descriptor instead.
+func (*GetIpDataResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetIpDataResponse) GetItems() []*GeoIpData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type IsoCodeNetworks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`     // "1.2.3.0/24"
+	Provenance    []string               `protobuf:"bytes,3,rep,name=provenance,proto3" json:"provenance,omitempty"` // source layer per network, if requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsoCodeNetworks) Reset() {
+	*x = IsoCodeNetworks{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsoCodeNetworks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsoCodeNetworks) ProtoMessage() {}
+
+func (x *IsoCodeNetworks) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsoCodeNetworks.ProtoReflect.Descriptor instead.
+func (*IsoCodeNetworks) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IsoCodeNetworks) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *IsoCodeNetworks) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *IsoCodeNetworks) GetProvenance() []string {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type GetCountryNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsoCodes      []string               `protobuf:"bytes,1,rep,name=iso_codes,json=isoCodes,proto3" json:"iso_codes,omitempty"` // ["RU","USA"] or group ids ["EU"], see GetGroups
+	Provenance    bool                   `protobuf:"varint,2,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	Basis         string                 `protobuf:"bytes,3,opt,name=basis,proto3" json:"basis,omitempty"` // located (default) | registered: list by registered_country
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryNetworksRequest) Reset() {
+	*x = GetCountryNetworksRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryNetworksRequest) ProtoMessage() {}
+
+func (x *GetCountryNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryNetworksRequest.ProtoReflect.Descriptor instead.
+func (*GetCountryNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCountryNetworksRequest) GetIsoCodes() []string {
+	if x != nil {
+		return x.IsoCodes
+	}
+	return nil
+}
+
+func (x *GetCountryNetworksRequest) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+func (x *GetCountryNetworksRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type GetCountryNetworksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*IsoCodeNetworks     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryNetworksResponse) Reset() {
+	*x = GetCountryNetworksResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryNetworksResponse) ProtoMessage() {}
+
+func (x *GetCountryNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryNetworksResponse.ProtoReflect.Descriptor instead.
+func (*GetCountryNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCountryNetworksResponse) GetItems() []*IsoCodeNetworks {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PageDataString struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []string               `protobuf:"bytes,1,rep,name=content,proto3" json:"content,omitempty"`
+	TotalElements int64                  `protobuf:"varint,2,opt,name=total_elements,json=totalElements,proto3" json:"total_elements,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Page          int64                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Provenance    []string               `protobuf:"bytes,6,rep,name=provenance,proto3" json:"provenance,omitempty"` // source layer per network, if requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageDataString) Reset() {
+	*x = PageDataString{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageDataString) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageDataString) ProtoMessage() {}
+
+func (x *PageDataString) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageDataString.ProtoReflect.Descriptor instead.
+func (*PageDataString) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PageDataString) GetContent() []string {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PageDataString) GetTotalElements() int64 {
+	if x != nil {
+		return x.TotalElements
+	}
+	return 0
+}
+
+func (x *PageDataString) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PageDataString) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PageDataString) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PageDataString) GetProvenance() []string {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type GetCountryNetworksPagedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsoCode       string                 `protobuf:"bytes,1,opt,name=iso_code,json=isoCode,proto3" json:"iso_code,omitempty"` // ISO2, ISO3 or group id
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Provenance    bool                   `protobuf:"varint,4,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	Basis         string                 `protobuf:"bytes,5,opt,name=basis,proto3" json:"basis,omitempty"` // located (default) | registered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryNetworksPagedRequest) Reset() {
+	*x = GetCountryNetworksPagedRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryNetworksPagedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryNetworksPagedRequest) ProtoMessage() {}
+
+func (x *GetCountryNetworksPagedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryNetworksPagedRequest.ProtoReflect.Descriptor instead.
+func (*GetCountryNetworksPagedRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCountryNetworksPagedRequest) GetIsoCode() string {
+	if x != nil {
+		return x.IsoCode
+	}
+	return ""
+}
+
+func (x *GetCountryNetworksPagedRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCountryNetworksPagedRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetCountryNetworksPagedRequest) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+func (x *GetCountryNetworksPagedRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type GetCountryNetworksStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsoCodes      []string               `protobuf:"bytes,1,rep,name=iso_codes,json=isoCodes,proto3" json:"iso_codes,omitempty"`     // ["RU","US"] or group ids ["EU"]
+	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // how many CIDR per message
+	Provenance    bool                   `protobuf:"varint,3,opt,name=provenance,proto3" json:"provenance,omitempty"`
+	Basis         string                 `protobuf:"bytes,4,opt,name=basis,proto3" json:"basis,omitempty"` // located (default) | registered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCountryNetworksStreamRequest) Reset() {
+	*x = GetCountryNetworksStreamRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCountryNetworksStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCountryNetworksStreamRequest) ProtoMessage() {}
+
+func (x *GetCountryNetworksStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCountryNetworksStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetCountryNetworksStreamRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCountryNetworksStreamRequest) GetIsoCodes() []string {
+	if x != nil {
+		return x.IsoCodes
+	}
+	return nil
+}
+
+func (x *GetCountryNetworksStreamRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *GetCountryNetworksStreamRequest) GetProvenance() bool {
+	if x != nil {
+		return x.Provenance
+	}
+	return false
+}
+
+func (x *GetCountryNetworksStreamRequest) GetBasis() string {
+	if x != nil {
+		return x.Basis
+	}
+	return ""
+}
+
+type CountryNetworksChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                // ISO2 or group id, as requested
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`                        // CIDR
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // 0.. (chunk number)
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // chunks count
+	Last          bool                   `protobuf:"varint,5,opt,name=last,proto3" json:"last,omitempty"`                               // last chunk fo country
+	Provenance    []string               `protobuf:"bytes,6,rep,name=provenance,proto3" json:"provenance,omitempty"`                    // source layer per network, if requested
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryNetworksChunk) Reset() {
+	*x = CountryNetworksChunk{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryNetworksChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryNetworksChunk) ProtoMessage() {}
+
+func (x *CountryNetworksChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryNetworksChunk.ProtoReflect.Descriptor instead.
+func (*CountryNetworksChunk) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountryNetworksChunk) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CountryNetworksChunk) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *CountryNetworksChunk) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CountryNetworksChunk) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *CountryNetworksChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *CountryNetworksChunk) GetProvenance() []string {
+	if x != nil {
+		return x.Provenance
+	}
+	return nil
+}
+
+type NetworkData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // CIDR
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`         // country | registered_country | represented_country | fallback | override
+	Provenance    string                 `protobuf:"bytes,4,opt,name=provenance,proto3" json:"provenance,omitempty"` // mmdb | rir | csv | overrides
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkData) Reset() {
+	*x = NetworkData{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkData) ProtoMessage() {}
+
+func (x *NetworkData) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkData.ProtoReflect.Descriptor instead.
+func (*NetworkData) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NetworkData) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NetworkData) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *NetworkData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NetworkData) GetProvenance() string {
+	if x != nil {
+		return x.Provenance
+	}
+	return ""
+}
+
+type GetCidrDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidrs         []string               `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs,omitempty"` // ["8.8.0.0/16"], at most 100
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`  // subnets page, the same for every CIDR
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`  // subnets page size, 0 = 1000, at most 100000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCidrDataRequest) Reset() {
+	*x = GetCidrDataRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCidrDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCidrDataRequest) ProtoMessage() {}
+
+func (x *GetCidrDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCidrDataRequest.ProtoReflect.Descriptor instead.
+func (*GetCidrDataRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCidrDataRequest) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *GetCidrDataRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCidrDataRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type CidrData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidr          string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`           // host bits cleared
+	Exact         *NetworkData           `protobuf:"bytes,2,opt,name=exact,proto3" json:"exact,omitempty"`         // set if the dataset has this very network
+	Supernets     []*NetworkData         `protobuf:"bytes,3,rep,name=supernets,proto3" json:"supernets,omitempty"` // networks containing cidr, widest first
+	Subnets       []*NetworkData         `protobuf:"bytes,4,rep,name=subnets,proto3" json:"subnets,omitempty"`     // page of networks inside cidr
+	TotalSubnets  int64                  `protobuf:"varint,5,opt,name=total_subnets,json=totalSubnets,proto3" json:"total_subnets,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,6,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Page          int64                  `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int64                  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CidrData) Reset() {
+	*x = CidrData{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CidrData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CidrData) ProtoMessage() {}
+
+func (x *CidrData) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CidrData.ProtoReflect.Descriptor instead.
+func (*CidrData) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CidrData) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+func (x *CidrData) GetExact() *NetworkData {
+	if x != nil {
+		return x.Exact
+	}
+	return nil
+}
+
+func (x *CidrData) GetSupernets() []*NetworkData {
+	if x != nil {
+		return x.Supernets
+	}
+	return nil
+}
+
+func (x *CidrData) GetSubnets() []*NetworkData {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *CidrData) GetTotalSubnets() int64 {
+	if x != nil {
+		return x.TotalSubnets
+	}
+	return 0
+}
+
+func (x *CidrData) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *CidrData) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CidrData) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetCidrDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CidrData            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCidrDataResponse) Reset() {
+	*x = GetCidrDataResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCidrDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCidrDataResponse) ProtoMessage() {}
+
+func (x *GetCidrDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCidrDataResponse.ProtoReflect.Descriptor instead.
+func (*GetCidrDataResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCidrDataResponse) GetItems() []*CidrData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetRangeBreakdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ranges        []string               `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"` // CIDR, "first-last" or a single address; at most 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRangeBreakdownRequest) Reset() {
+	*x = GetRangeBreakdownRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRangeBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRangeBreakdownRequest) ProtoMessage() {}
+
+func (x *GetRangeBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRangeBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*GetRangeBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRangeBreakdownRequest) GetRanges() []string {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type CountryShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`   // dataset networks answering for part of the range
+	Addresses     string                 `protobuf:"bytes,3,opt,name=addresses,proto3" json:"addresses,omitempty"` // exact decimal count, IPv6 does not fit int64
+	Percent       float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`   // of the range
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryShare) Reset() {
+	*x = CountryShare{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryShare) ProtoMessage() {}
+
+func (x *CountryShare) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryShare.ProtoReflect.Descriptor instead.
+func (*CountryShare) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CountryShare) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CountryShare) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *CountryShare) GetAddresses() string {
+	if x != nil {
+		return x.Addresses
+	}
+	return ""
+}
+
+func (x *CountryShare) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type RangeBreakdown struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Query                string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	First                string                 `protobuf:"bytes,2,opt,name=first,proto3" json:"first,omitempty"`
+	Last                 string                 `protobuf:"bytes,3,opt,name=last,proto3" json:"last,omitempty"`
+	Addresses            string                 `protobuf:"bytes,4,opt,name=addresses,proto3" json:"addresses,omitempty"`
+	Countries            []*CountryShare        `protobuf:"bytes,5,rep,name=countries,proto3" json:"countries,omitempty"`     // most addresses first
+	Unallocated          []string               `protobuf:"bytes,6,rep,name=unallocated,proto3" json:"unallocated,omitempty"` // "first-last" parts not in the dataset
+	UnallocatedAddresses string                 `protobuf:"bytes,7,opt,name=unallocated_addresses,json=unallocatedAddresses,proto3" json:"unallocated_addresses,omitempty"`
+	UnallocatedPercent   float64                `protobuf:"fixed64,8,opt,name=unallocated_percent,json=unallocatedPercent,proto3" json:"unallocated_percent,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RangeBreakdown) Reset() {
+	*x = RangeBreakdown{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeBreakdown) ProtoMessage() {}
+
+func (x *RangeBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeBreakdown.ProtoReflect.Descriptor instead.
+func (*RangeBreakdown) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RangeBreakdown) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *RangeBreakdown) GetFirst() string {
+	if x != nil {
+		return x.First
+	}
+	return ""
+}
+
+func (x *RangeBreakdown) GetLast() string {
+	if x != nil {
+		return x.Last
+	}
+	return ""
+}
+
+func (x *RangeBreakdown) GetAddresses() string {
+	if x != nil {
+		return x.Addresses
+	}
+	return ""
+}
+
+func (x *RangeBreakdown) GetCountries() []*CountryShare {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *RangeBreakdown) GetUnallocated() []string {
+	if x != nil {
+		return x.Unallocated
+	}
+	return nil
+}
+
+func (x *RangeBreakdown) GetUnallocatedAddresses() string {
+	if x != nil {
+		return x.UnallocatedAddresses
+	}
+	return ""
+}
+
+func (x *RangeBreakdown) GetUnallocatedPercent() float64 {
+	if x != nil {
+		return x.UnallocatedPercent
+	}
+	return 0
+}
+
+type GetRangeBreakdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*RangeBreakdown      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRangeBreakdownResponse) Reset() {
+	*x = GetRangeBreakdownResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRangeBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRangeBreakdownResponse) ProtoMessage() {}
+
+func (x *GetRangeBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRangeBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*GetRangeBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRangeBreakdownResponse) GetItems() []*RangeBreakdown {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type EvaluateExpressionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"` // "EU + CH - 185.0.0.0/16"
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`         // json (default) | text | nginx | ipset | nftables
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`             // exported set name, default "geocoder"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionRequest) Reset() {
+	*x = EvaluateExpressionRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionRequest) ProtoMessage() {}
+
+func (x *EvaluateExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EvaluateExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EvaluateExpressionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"` // canonical form
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`     // minimal CIDR list, IPv4 first
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Export        string                 `protobuf:"bytes,4,opt,name=export,proto3" json:"export,omitempty"` // networks rendered in format, unless json
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateExpressionResponse) Reset() {
+	*x = EvaluateExpressionResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateExpressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateExpressionResponse) ProtoMessage() {}
+
+func (x *EvaluateExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateExpressionResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EvaluateExpressionResponse) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResponse) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *EvaluateExpressionResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *EvaluateExpressionResponse) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
+type GetBogonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Family        string                 `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"` // ipv4 | ipv6
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // json (default) | text | nginx | ipset | nftables
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`     // exported set name, default "geocoder"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBogonsRequest) Reset() {
+	*x = GetBogonsRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBogonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBogonsRequest) ProtoMessage() {}
+
+func (x *GetBogonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBogonsRequest.ProtoReflect.Descriptor instead.
+func (*GetBogonsRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBogonsRequest) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *GetBogonsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetBogonsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetBogonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Family        string                 `protobuf:"bytes,1,opt,name=family,proto3" json:"family,omitempty"`
+	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"` // minimal CIDR list in address order
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Export        string                 `protobuf:"bytes,4,opt,name=export,proto3" json:"export,omitempty"` // networks rendered in format, unless json
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBogonsResponse) Reset() {
+	*x = GetBogonsResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBogonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBogonsResponse) ProtoMessage() {}
+
+func (x *GetBogonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBogonsResponse.ProtoReflect.Descriptor instead.
+func (*GetBogonsResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetBogonsResponse) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *GetBogonsResponse) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *GetBogonsResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GetBogonsResponse) GetExport() string {
+	if x != nil {
+		return x.Export
+	}
+	return ""
+}
+
+type CountryGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // EU, EUROPE, ...
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`       // continent | union | custom
+	Members       []string               `protobuf:"bytes,4,rep,name=members,proto3" json:"members,omitempty"` // ISO2, sorted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryGroup) Reset() {
+	*x = CountryGroup{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryGroup) ProtoMessage() {}
+
+func (x *CountryGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryGroup.ProtoReflect.Descriptor instead.
+func (*CountryGroup) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CountryGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CountryGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CountryGroup) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CountryGroup) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CountryGroup        `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsResponse) ProtoMessage() {}
+
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetGroupsResponse) GetGroups() []*CountryGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetQualityReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`                                // entries per list, 0 = 100
+	Threshold     float64                `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`                       // country change threshold in percent, 0 = 20
+	MinNetworks   int32                  `protobuf:"varint,3,opt,name=min_networks,json=minNetworks,proto3" json:"min_networks,omitempty"` // smaller countries are not compared, 0 = 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQualityReportRequest) Reset() {
+	*x = GetQualityReportRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQualityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQualityReportRequest) ProtoMessage() {}
+
+func (x *GetQualityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQualityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetQualityReportRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetQualityReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQualityReportRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *GetQualityReportRequest) GetMinNetworks() int32 {
+	if x != nil {
+		return x.MinNetworks
+	}
+	return 0
+}
+
+type NetworkIssue struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Network        string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	RegisteredCode string                 `protobuf:"bytes,3,opt,name=registered_code,json=registeredCode,proto3" json:"registered_code,omitempty"` // set for registered-differs issues
+	Provenance     string                 `protobuf:"bytes,4,opt,name=provenance,proto3" json:"provenance,omitempty"`                               // mmdb | rir | csv | overrides
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NetworkIssue) Reset() {
+	*x = NetworkIssue{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkIssue) ProtoMessage() {}
+
+func (x *NetworkIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkIssue.ProtoReflect.Descriptor instead.
+func (*NetworkIssue) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *NetworkIssue) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NetworkIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *NetworkIssue) GetRegisteredCode() string {
+	if x != nil {
+		return x.RegisteredCode
+	}
+	return ""
+}
+
+func (x *NetworkIssue) GetProvenance() string {
+	if x != nil {
+		return x.Provenance
+	}
+	return ""
+}
+
+type AliasConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // IPv6 network inside an alias range
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Alias         string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`                                // ipv4_mapped | 6to4 | teredo
+	Ipv4Network   string                 `protobuf:"bytes,4,opt,name=ipv4_network,json=ipv4Network,proto3" json:"ipv4_network,omitempty"` // embedded IPv4 network
+	Ipv4Code      string                 `protobuf:"bytes,5,opt,name=ipv4_code,json=ipv4Code,proto3" json:"ipv4_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AliasConflict) Reset() {
+	*x = AliasConflict{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AliasConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AliasConflict) ProtoMessage() {}
+
+func (x *AliasConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AliasConflict.ProtoReflect.Descriptor instead.
+func (*AliasConflict) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AliasConflict) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AliasConflict) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AliasConflict) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *AliasConflict) GetIpv4Network() string {
+	if x != nil {
+		return x.Ipv4Network
+	}
+	return ""
+}
+
+func (x *AliasConflict) GetIpv4Code() string {
+	if x != nil {
+		return x.Ipv4Code
+	}
+	return ""
+}
+
+type CountryCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      int32                  `protobuf:"varint,1,opt,name=networks,proto3" json:"networks,omitempty"`
+	Ipv4Addresses uint64                 `protobuf:"varint,2,opt,name=ipv4_addresses,json=ipv4Addresses,proto3" json:"ipv4_addresses,omitempty"`
+	Ipv6Slash64S  uint64                 `protobuf:"varint,3,opt,name=ipv6_slash64s,json=ipv6Slash64s,proto3" json:"ipv6_slash64s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryCount) Reset() {
+	*x = CountryCount{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryCount) ProtoMessage() {}
+
+func (x *CountryCount) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryCount.ProtoReflect.Descriptor instead.
+func (*CountryCount) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CountryCount) GetNetworks() int32 {
+	if x != nil {
+		return x.Networks
+	}
+	return 0
+}
+
+func (x *CountryCount) GetIpv4Addresses() uint64 {
+	if x != nil {
+		return x.Ipv4Addresses
+	}
+	return 0
+}
+
+func (x *CountryCount) GetIpv6Slash64S() uint64 {
+	if x != nil {
+		return x.Ipv6Slash64S
+	}
+	return 0
+}
+
+type CountryChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Before        *CountryCount          `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         *CountryCount          `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+	Percent       float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"` // largest relative change; new or gone = +-100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountryChange) Reset() {
+	*x = CountryChange{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountryChange) ProtoMessage() {}
+
+func (x *CountryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountryChange.ProtoReflect.Descriptor instead.
+func (*CountryChange) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CountryChange) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CountryChange) GetBefore() *CountryCount {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *CountryChange) GetAfter() *CountryCount {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *CountryChange) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type QualityReport struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	RegisteredDiffers      []*NetworkIssue        `protobuf:"bytes,1,rep,name=registered_differs,json=registeredDiffers,proto3" json:"registered_differs,omitempty"`
+	TotalRegisteredDiffers int64                  `protobuf:"varint,2,opt,name=total_registered_differs,json=totalRegisteredDiffers,proto3" json:"total_registered_differs,omitempty"`
+	Fallbacks              []*NetworkIssue        `protobuf:"bytes,3,rep,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+	TotalFallbacks         int64                  `protobuf:"varint,4,opt,name=total_fallbacks,json=totalFallbacks,proto3" json:"total_fallbacks,omitempty"`
+	AliasConflicts         []*AliasConflict       `protobuf:"bytes,5,rep,name=alias_conflicts,json=aliasConflicts,proto3" json:"alias_conflicts,omitempty"` // only without SkipAliasedNetworks
+	TotalAliasConflicts    int64                  `protobuf:"varint,6,opt,name=total_alias_conflicts,json=totalAliasConflicts,proto3" json:"total_alias_conflicts,omitempty"`
+	HasPrevious            bool                   `protobuf:"varint,7,opt,name=has_previous,json=hasPrevious,proto3" json:"has_previous,omitempty"` // a previous build is known
+	Changes                []*CountryChange       `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`                             // largest change first
+	TotalChanges           int64                  `protobuf:"varint,9,opt,name=total_changes,json=totalChanges,proto3" json:"total_changes,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *QualityReport) Reset() {
+	*x = QualityReport{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QualityReport) ProtoMessage() {}
+
+func (x *QualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QualityReport.ProtoReflect.Descriptor instead.
+func (*QualityReport) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QualityReport) GetRegisteredDiffers() []*NetworkIssue {
+	if x != nil {
+		return x.RegisteredDiffers
+	}
+	return nil
+}
+
+func (x *QualityReport) GetTotalRegisteredDiffers() int64 {
+	if x != nil {
+		return x.TotalRegisteredDiffers
+	}
+	return 0
+}
+
+func (x *QualityReport) GetFallbacks() []*NetworkIssue {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return nil
+}
+
+func (x *QualityReport) GetTotalFallbacks() int64 {
+	if x != nil {
+		return x.TotalFallbacks
+	}
+	return 0
+}
+
+func (x *QualityReport) GetAliasConflicts() []*AliasConflict {
+	if x != nil {
+		return x.AliasConflicts
+	}
+	return nil
+}
+
+func (x *QualityReport) GetTotalAliasConflicts() int64 {
+	if x != nil {
+		return x.TotalAliasConflicts
+	}
+	return 0
+}
+
+func (x *QualityReport) GetHasPrevious() bool {
+	if x != nil {
+		return x.HasPrevious
+	}
+	return false
+}
+
+func (x *QualityReport) GetChanges() []*CountryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *QualityReport) GetTotalChanges() int64 {
+	if x != nil {
+		return x.TotalChanges
 	}
 	return 0
 }
 
-func (x *PageDataString) GetPage() int64 {
+type ExplainIpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExplainIpRequest) Reset() {
+	*x = ExplainIpRequest{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainIpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainIpRequest) ProtoMessage() {}
+
+func (x *ExplainIpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainIpRequest.ProtoReflect.Descriptor instead.
+func (*ExplainIpRequest) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExplainIpRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type NormalizeStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // country | registered_country | represented_country
+	Raw           string                 `protobuf:"bytes,2,opt,name=raw,proto3" json:"raw,omitempty"`
+	Normalized    string                 `protobuf:"bytes,3,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	Selected      bool                   `protobuf:"varint,4,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalizeStep) Reset() {
+	*x = NormalizeStep{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalizeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeStep) ProtoMessage() {}
+
+func (x *NormalizeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeStep.ProtoReflect.Descriptor instead.
+func (*NormalizeStep) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NormalizeStep) GetField() string {
 	if x != nil {
-		return x.Page
+		return x.Field
 	}
-	return 0
+	return ""
 }
 
-func (x *PageDataString) GetSize() int64 {
+func (x *NormalizeStep) GetRaw() string {
 	if x != nil {
-		return x.Size
+		return x.Raw
 	}
-	return 0
+	return ""
 }
 
-type GetCountryNetworksPagedRequest struct {
+func (x *NormalizeStep) GetNormalized() string {
+	if x != nil {
+		return x.Normalized
+	}
+	return ""
+}
+
+func (x *NormalizeStep) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type DatasetInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsoCode       string                 `protobuf:"bytes,1,opt,name=iso_code,json=isoCode,proto3" json:"iso_code,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	BuildEpoch    int64                  `protobuf:"varint,2,opt,name=build_epoch,json=buildEpoch,proto3" json:"build_epoch,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCountryNetworksPagedRequest) Reset() {
-	*x = GetCountryNetworksPagedRequest{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[11]
+func (x *DatasetInfo) Reset() {
+	*x = DatasetInfo{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCountryNetworksPagedRequest) String() string {
+func (x *DatasetInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCountryNetworksPagedRequest) ProtoMessage() {}
+func (*DatasetInfo) ProtoMessage() {}
 
-func (x *GetCountryNetworksPagedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[11]
+func (x *DatasetInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -612,55 +2791,56 @@ func (x *GetCountryNetworksPagedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCountryNetworksPagedRequest.ProtoReflect.Descriptor instead.
-func (*GetCountryNetworksPagedRequest) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DatasetInfo.ProtoReflect.Descriptor instead.
+func (*DatasetInfo) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetCountryNetworksPagedRequest) GetIsoCode() string {
+func (x *DatasetInfo) GetType() string {
 	if x != nil {
-		return x.IsoCode
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetCountryNetworksPagedRequest) GetPage() int32 {
+func (x *DatasetInfo) GetBuildEpoch() int64 {
 	if x != nil {
-		return x.Page
+		return x.BuildEpoch
 	}
 	return 0
 }
 
-func (x *GetCountryNetworksPagedRequest) GetSize() int32 {
+func (x *DatasetInfo) GetDescription() string {
 	if x != nil {
-		return x.Size
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-type GetCountryNetworksStreamRequest struct {
+type SourceInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsoCodes      []string               `protobuf:"bytes,1,rep,name=iso_codes,json=isoCodes,proto3" json:"iso_codes,omitempty"`     // ["RU","US"]
-	ChunkSize     int32                  `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"` // how many CIDR per message
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // mmdb | rir | csv | overrides
+	Dataset       *DatasetInfo           `protobuf:"bytes,2,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Networks      int64                  `protobuf:"varint,3,opt,name=networks,proto3" json:"networks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCountryNetworksStreamRequest) Reset() {
-	*x = GetCountryNetworksStreamRequest{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[12]
+func (x *SourceInfo) Reset() {
+	*x = SourceInfo{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCountryNetworksStreamRequest) String() string {
+func (x *SourceInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCountryNetworksStreamRequest) ProtoMessage() {}
+func (*SourceInfo) ProtoMessage() {}
 
-func (x *GetCountryNetworksStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[12]
+func (x *SourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,51 +2851,55 @@ func (x *GetCountryNetworksStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCountryNetworksStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetCountryNetworksStreamRequest) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SourceInfo.ProtoReflect.Descriptor instead.
+func (*SourceInfo) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetCountryNetworksStreamRequest) GetIsoCodes() []string {
+func (x *SourceInfo) GetName() string {
 	if x != nil {
-		return x.IsoCodes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SourceInfo) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
 	}
 	return nil
 }
 
-func (x *GetCountryNetworksStreamRequest) GetChunkSize() int32 {
+func (x *SourceInfo) GetNetworks() int64 {
 	if x != nil {
-		return x.ChunkSize
+		return x.Networks
 	}
 	return 0
 }
 
-type CountryNetworksChunk struct {
+type GetDatasetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                // ISO2
-	Networks      []string               `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`                        // CIDR
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                               // 0.. (chunk number)
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // chunks count
-	Last          bool                   `protobuf:"varint,5,opt,name=last,proto3" json:"last,omitempty"`                               // last chunk fo country
+	Dataset       *DatasetInfo           `protobuf:"bytes,1,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Sources       []*SourceInfo          `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"` // highest precedence first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountryNetworksChunk) Reset() {
-	*x = CountryNetworksChunk{}
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[13]
+func (x *GetDatasetResponse) Reset() {
+	*x = GetDatasetResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountryNetworksChunk) String() string {
+func (x *GetDatasetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountryNetworksChunk) ProtoMessage() {}
+func (*GetDatasetResponse) ProtoMessage() {}
 
-func (x *CountryNetworksChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_geocoder_v1_geocoder_proto_msgTypes[13]
+func (x *GetDatasetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,46 +2910,265 @@ func (x *CountryNetworksChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountryNetworksChunk.ProtoReflect.Descriptor instead.
-func (*CountryNetworksChunk) Descriptor() ([]byte, []int) {
-	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetDatasetResponse.ProtoReflect.Descriptor instead.
+func (*GetDatasetResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CountryNetworksChunk) GetCode() string {
+func (x *GetDatasetResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *GetDatasetResponse) GetSources() []*SourceInfo {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type OverrideInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Expires       int64                  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"` // unix seconds, 0 = never
+	Origin        string                 `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`    // "file:line"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverrideInfo) Reset() {
+	*x = OverrideInfo{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverrideInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverrideInfo) ProtoMessage() {}
+
+func (x *OverrideInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverrideInfo.ProtoReflect.Descriptor instead.
+func (*OverrideInfo) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OverrideInfo) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *OverrideInfo) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *CountryNetworksChunk) GetNetworks() []string {
+func (x *OverrideInfo) GetComment() string {
 	if x != nil {
-		return x.Networks
+		return x.Comment
 	}
-	return nil
+	return ""
 }
 
-func (x *CountryNetworksChunk) GetPage() int32 {
+func (x *OverrideInfo) GetExpires() int64 {
 	if x != nil {
-		return x.Page
+		return x.Expires
 	}
 	return 0
 }
 
-func (x *CountryNetworksChunk) GetTotalPages() int32 {
+func (x *OverrideInfo) GetOrigin() string {
 	if x != nil {
-		return x.TotalPages
+		return x.Origin
 	}
-	return 0
+	return ""
 }
 
-func (x *CountryNetworksChunk) GetLast() bool {
+type ExplainIpResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ip              string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Address         string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Alias           string                 `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"` // none | ipv4_mapped | 6to4 | teredo
+	EmbeddedIpv4    string                 `protobuf:"bytes,4,opt,name=embedded_ipv4,json=embeddedIpv4,proto3" json:"embedded_ipv4,omitempty"`
+	AliasApplied    bool                   `protobuf:"varint,5,opt,name=alias_applied,json=aliasApplied,proto3" json:"alias_applied,omitempty"`
+	LookupAddress   string                 `protobuf:"bytes,6,opt,name=lookup_address,json=lookupAddress,proto3" json:"lookup_address,omitempty"`
+	Found           bool                   `protobuf:"varint,7,opt,name=found,proto3" json:"found,omitempty"`
+	Network         string                 `protobuf:"bytes,8,opt,name=network,proto3" json:"network,omitempty"`
+	RecordJson      string                 `protobuf:"bytes,9,opt,name=record_json,json=recordJson,proto3" json:"record_json,omitempty"` // raw MMDB record
+	Normalization   []*NormalizeStep       `protobuf:"bytes,10,rep,name=normalization,proto3" json:"normalization,omitempty"`
+	Code            string                 `protobuf:"bytes,11,opt,name=code,proto3" json:"code,omitempty"`
+	Source          string                 `protobuf:"bytes,12,opt,name=source,proto3" json:"source,omitempty"`
+	Dataset         *DatasetInfo           `protobuf:"bytes,13,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	DatabaseNetwork string                 `protobuf:"bytes,14,opt,name=database_network,json=databaseNetwork,proto3" json:"database_network,omitempty"` // database answer before overrides
+	DatabaseCode    string                 `protobuf:"bytes,15,opt,name=database_code,json=databaseCode,proto3" json:"database_code,omitempty"`
+	Override        *OverrideInfo          `protobuf:"bytes,16,opt,name=override,proto3" json:"override,omitempty"` // set if a local override matched
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExplainIpResponse) Reset() {
+	*x = ExplainIpResponse{}
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExplainIpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainIpResponse) ProtoMessage() {}
+
+func (x *ExplainIpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_geocoder_v1_geocoder_proto_msgTypes[45]
 	if x != nil {
-		return x.Last
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainIpResponse.ProtoReflect.Descriptor instead.
+func (*ExplainIpResponse) Descriptor() ([]byte, []int) {
+	return file_geocoder_v1_geocoder_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExplainIpResponse) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetAlias() string {
+	if x != nil {
+		return x.Alias
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetEmbeddedIpv4() string {
+	if x != nil {
+		return x.EmbeddedIpv4
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetAliasApplied() bool {
+	if x != nil {
+		return x.AliasApplied
+	}
+	return false
+}
+
+func (x *ExplainIpResponse) GetLookupAddress() string {
+	if x != nil {
+		return x.LookupAddress
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
 	}
 	return false
 }
 
+func (x *ExplainIpResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetRecordJson() string {
+	if x != nil {
+		return x.RecordJson
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetNormalization() []*NormalizeStep {
+	if x != nil {
+		return x.Normalization
+	}
+	return nil
+}
+
+func (x *ExplainIpResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetDataset() *DatasetInfo {
+	if x != nil {
+		return x.Dataset
+	}
+	return nil
+}
+
+func (x *ExplainIpResponse) GetDatabaseNetwork() string {
+	if x != nil {
+		return x.DatabaseNetwork
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetDatabaseCode() string {
+	if x != nil {
+		return x.DatabaseCode
+	}
+	return ""
+}
+
+func (x *ExplainIpResponse) GetOverride() *OverrideInfo {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
 var File_geocoder_v1_geocoder_proto protoreflect.FileDescriptor
 
 const file_geocoder_v1_geocoder_proto_rawDesc = "" +
@@ -773,58 +3176,289 @@ const file_geocoder_v1_geocoder_proto_rawDesc = "" +
 	"\x1ageocoder/v1/geocoder.proto\x12\vgeocoder.v1\x1a\x1bgoogle/protobuf/empty.proto\"I\n" +
 	"\x06Health\x12%\n" +
 	"\x0euptime_seconds\x18\x01 \x01(\x05R\ruptimeSeconds\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"I\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\xdb\x01\n" +
 	"\x10CountryRangeData\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12!\n" +
-	"\franges_count\x18\x02 \x01(\x05R\vrangesCount\"S\n" +
+	"\franges_count\x18\x02 \x01(\x05R\vrangesCount\x12%\n" +
+	"\x0eipv4_addresses\x18\x03 \x01(\x04R\ripv4Addresses\x12#\n" +
+	"\ripv6_slash64s\x18\x04 \x01(\x04R\fipv6Slash64s\x12!\n" +
+	"\fipv4_percent\x18\x05 \x01(\x01R\vipv4Percent\x12!\n" +
+	"\fipv6_percent\x18\x06 \x01(\x01R\vipv6Percent\"S\n" +
 	"\x14GetCountriesResponse\x12;\n" +
-	"\tcountries\x18\x01 \x03(\v2\x1d.geocoder.v1.CountryRangeDataR\tcountries\"\x1b\n" +
+	"\tcountries\x18\x01 \x03(\v2\x1d.geocoder.v1.CountryRangeDataR\tcountries\"'\n" +
+	"\x11GetCountryRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xc5\x01\n" +
+	"\fAddressSpace\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x01(\x05R\bnetworks\x12\x1c\n" +
+	"\taddresses\x18\x02 \x01(\tR\taddresses\x12\x1a\n" +
+	"\bslash64s\x18\x03 \x01(\x04R\bslash64s\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12E\n" +
+	"\x0eprefix_lengths\x18\x05 \x03(\v2\x1e.geocoder.v1.PrefixLengthCountR\rprefixLengths\"C\n" +
+	"\x11PrefixLengthCount\x12\x12\n" +
+	"\x04bits\x18\x01 \x01(\x05R\x04bits\x12\x1a\n" +
+	"\bnetworks\x18\x02 \x01(\x05R\bnetworks\"\xfb\x01\n" +
+	"\vCountryInfo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06alpha3\x18\x02 \x01(\tR\x06alpha3\x12\x18\n" +
+	"\anumeric\x18\x03 \x01(\tR\anumeric\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1c\n" +
+	"\tcontinent\x18\x05 \x01(\tR\tcontinent\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12-\n" +
+	"\x04ipv4\x18\a \x01(\v2\x19.geocoder.v1.AddressSpaceR\x04ipv4\x12-\n" +
+	"\x04ipv6\x18\b \x01(\v2\x19.geocoder.v1.AddressSpaceR\x04ipv6\"\x1b\n" +
 	"\tIpPayload\x12\x0e\n" +
-	"\x02ip\x18\x01 \x01(\tR\x02ip\"R\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\"\xd3\x03\n" +
 	"\tGeoIpData\x12\x0e\n" +
-	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x12\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12#\n" +
+	"\rnormalized_ip\x18\x0e \x01(\tR\fnormalizedIp\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12!\n" +
-	"\fcountry_name\x18\x03 \x01(\tR\vcountryName\"<\n" +
+	"\fcountry_name\x18\x03 \x01(\tR\vcountryName\x12\x18\n" +
+	"\anetwork\x18\x04 \x01(\tR\anetwork\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12'\n" +
+	"\x0fregistered_code\x18\x06 \x01(\tR\x0eregisteredCode\x12<\n" +
+	"\x1aregistered_country_differs\x18\a \x01(\bR\x18registeredCountryDiffers\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\b \x01(\tR\n" +
+	"provenance\x12#\n" +
+	"\raddress_class\x18\t \x01(\tR\faddressClass\x123\n" +
+	"\aspecial\x18\n" +
+	" \x01(\v2\x19.geocoder.v1.SpecialBlockR\aspecial\x12\x14\n" +
+	"\x05bogon\x18\v \x01(\bR\x05bogon\x12\x14\n" +
+	"\x05alias\x18\f \x01(\tR\x05alias\x12\x1b\n" +
+	"\tlookup_ip\x18\r \x01(\tR\blookupIp\"N\n" +
+	"\fSpecialBlock\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03rfc\x18\x03 \x01(\tR\x03rfc\"r\n" +
 	"\x10GetIpDataRequest\x12(\n" +
-	"\x03ips\x18\x01 \x03(\v2\x16.geocoder.v1.IpPayloadR\x03ips\"A\n" +
+	"\x03ips\x18\x01 \x03(\v2\x16.geocoder.v1.IpPayloadR\x03ips\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x02 \x01(\bR\n" +
+	"provenance\x12\x14\n" +
+	"\x05basis\x18\x03 \x01(\tR\x05basis\"A\n" +
 	"\x11GetIpDataResponse\x12,\n" +
-	"\x05items\x18\x01 \x03(\v2\x16.geocoder.v1.GeoIpDataR\x05items\"A\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.geocoder.v1.GeoIpDataR\x05items\"a\n" +
 	"\x0fIsoCodeNetworks\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
-	"\bnetworks\x18\x02 \x03(\tR\bnetworks\"8\n" +
+	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x03 \x03(\tR\n" +
+	"provenance\"n\n" +
 	"\x19GetCountryNetworksRequest\x12\x1b\n" +
-	"\tiso_codes\x18\x01 \x03(\tR\bisoCodes\"P\n" +
+	"\tiso_codes\x18\x01 \x03(\tR\bisoCodes\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x02 \x01(\bR\n" +
+	"provenance\x12\x14\n" +
+	"\x05basis\x18\x03 \x01(\tR\x05basis\"P\n" +
 	"\x1aGetCountryNetworksResponse\x122\n" +
-	"\x05items\x18\x01 \x03(\v2\x1c.geocoder.v1.IsoCodeNetworksR\x05items\"\x9a\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.geocoder.v1.IsoCodeNetworksR\x05items\"\xba\x01\n" +
 	"\x0ePageDataString\x12\x18\n" +
 	"\acontent\x18\x01 \x03(\tR\acontent\x12%\n" +
 	"\x0etotal_elements\x18\x02 \x01(\x03R\rtotalElements\x12\x1f\n" +
 	"\vtotal_pages\x18\x03 \x01(\x03R\n" +
 	"totalPages\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x03R\x04page\x12\x12\n" +
-	"\x04size\x18\x05 \x01(\x03R\x04size\"c\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x06 \x03(\tR\n" +
+	"provenance\"\x99\x01\n" +
 	"\x1eGetCountryNetworksPagedRequest\x12\x19\n" +
 	"\biso_code\x18\x01 \x01(\tR\aisoCode\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x05R\x04size\"]\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x04 \x01(\bR\n" +
+	"provenance\x12\x14\n" +
+	"\x05basis\x18\x05 \x01(\tR\x05basis\"\x93\x01\n" +
 	"\x1fGetCountryNetworksStreamRequest\x12\x1b\n" +
 	"\tiso_codes\x18\x01 \x03(\tR\bisoCodes\x12\x1d\n" +
 	"\n" +
-	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\"\x8f\x01\n" +
+	"chunk_size\x18\x02 \x01(\x05R\tchunkSize\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x03 \x01(\bR\n" +
+	"provenance\x12\x14\n" +
+	"\x05basis\x18\x04 \x01(\tR\x05basis\"\xaf\x01\n" +
 	"\x14CountryNetworksChunk\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
 	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
 	"totalPages\x12\x12\n" +
-	"\x04last\x18\x05 \x01(\bR\x04last2\x9d\x04\n" +
+	"\x04last\x18\x05 \x01(\bR\x04last\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x06 \x03(\tR\n" +
+	"provenance\"s\n" +
+	"\vNetworkData\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x04 \x01(\tR\n" +
+	"provenance\"R\n" +
+	"\x12GetCidrDataRequest\x12\x14\n" +
+	"\x05cidrs\x18\x01 \x03(\tR\x05cidrs\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\"\xa8\x02\n" +
+	"\bCidrData\x12\x12\n" +
+	"\x04cidr\x18\x01 \x01(\tR\x04cidr\x12.\n" +
+	"\x05exact\x18\x02 \x01(\v2\x18.geocoder.v1.NetworkDataR\x05exact\x126\n" +
+	"\tsupernets\x18\x03 \x03(\v2\x18.geocoder.v1.NetworkDataR\tsupernets\x122\n" +
+	"\asubnets\x18\x04 \x03(\v2\x18.geocoder.v1.NetworkDataR\asubnets\x12#\n" +
+	"\rtotal_subnets\x18\x05 \x01(\x03R\ftotalSubnets\x12\x1f\n" +
+	"\vtotal_pages\x18\x06 \x01(\x03R\n" +
+	"totalPages\x12\x12\n" +
+	"\x04page\x18\a \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\b \x01(\x03R\x04size\"B\n" +
+	"\x13GetCidrDataResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.geocoder.v1.CidrDataR\x05items\"2\n" +
+	"\x18GetRangeBreakdownRequest\x12\x16\n" +
+	"\x06ranges\x18\x01 \x03(\tR\x06ranges\"v\n" +
+	"\fCountryShare\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x1c\n" +
+	"\taddresses\x18\x03 \x01(\tR\taddresses\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\"\xaf\x02\n" +
+	"\x0eRangeBreakdown\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\tR\x05first\x12\x12\n" +
+	"\x04last\x18\x03 \x01(\tR\x04last\x12\x1c\n" +
+	"\taddresses\x18\x04 \x01(\tR\taddresses\x127\n" +
+	"\tcountries\x18\x05 \x03(\v2\x19.geocoder.v1.CountryShareR\tcountries\x12 \n" +
+	"\vunallocated\x18\x06 \x03(\tR\vunallocated\x123\n" +
+	"\x15unallocated_addresses\x18\a \x01(\tR\x14unallocatedAddresses\x12/\n" +
+	"\x13unallocated_percent\x18\b \x01(\x01R\x12unallocatedPercent\"N\n" +
+	"\x19GetRangeBreakdownResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.geocoder.v1.RangeBreakdownR\x05items\"g\n" +
+	"\x19EvaluateExpressionRequest\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x88\x01\n" +
+	"\x1aEvaluateExpressionResponse\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\x12\x1a\n" +
+	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x16\n" +
+	"\x06export\x18\x04 \x01(\tR\x06export\"V\n" +
+	"\x10GetBogonsRequest\x12\x16\n" +
+	"\x06family\x18\x01 \x01(\tR\x06family\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"w\n" +
+	"\x11GetBogonsResponse\x12\x16\n" +
+	"\x06family\x18\x01 \x01(\tR\x06family\x12\x1a\n" +
+	"\bnetworks\x18\x02 \x03(\tR\bnetworks\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x16\n" +
+	"\x06export\x18\x04 \x01(\tR\x06export\"`\n" +
+	"\fCountryGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x18\n" +
+	"\amembers\x18\x04 \x03(\tR\amembers\"F\n" +
+	"\x11GetGroupsResponse\x121\n" +
+	"\x06groups\x18\x01 \x03(\v2\x19.geocoder.v1.CountryGroupR\x06groups\"p\n" +
+	"\x17GetQualityReportRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x01R\tthreshold\x12!\n" +
+	"\fmin_networks\x18\x03 \x01(\x05R\vminNetworks\"\x85\x01\n" +
+	"\fNetworkIssue\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12'\n" +
+	"\x0fregistered_code\x18\x03 \x01(\tR\x0eregisteredCode\x12\x1e\n" +
+	"\n" +
+	"provenance\x18\x04 \x01(\tR\n" +
+	"provenance\"\x93\x01\n" +
+	"\rAliasConflict\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\x12!\n" +
+	"\fipv4_network\x18\x04 \x01(\tR\vipv4Network\x12\x1b\n" +
+	"\tipv4_code\x18\x05 \x01(\tR\bipv4Code\"v\n" +
+	"\fCountryCount\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x01(\x05R\bnetworks\x12%\n" +
+	"\x0eipv4_addresses\x18\x02 \x01(\x04R\ripv4Addresses\x12#\n" +
+	"\ripv6_slash64s\x18\x03 \x01(\x04R\fipv6Slash64s\"\xa1\x01\n" +
+	"\rCountryChange\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x121\n" +
+	"\x06before\x18\x02 \x01(\v2\x19.geocoder.v1.CountryCountR\x06before\x12/\n" +
+	"\x05after\x18\x03 \x01(\v2\x19.geocoder.v1.CountryCountR\x05after\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\"\xec\x03\n" +
+	"\rQualityReport\x12H\n" +
+	"\x12registered_differs\x18\x01 \x03(\v2\x19.geocoder.v1.NetworkIssueR\x11registeredDiffers\x128\n" +
+	"\x18total_registered_differs\x18\x02 \x01(\x03R\x16totalRegisteredDiffers\x127\n" +
+	"\tfallbacks\x18\x03 \x03(\v2\x19.geocoder.v1.NetworkIssueR\tfallbacks\x12'\n" +
+	"\x0ftotal_fallbacks\x18\x04 \x01(\x03R\x0etotalFallbacks\x12C\n" +
+	"\x0falias_conflicts\x18\x05 \x03(\v2\x1a.geocoder.v1.AliasConflictR\x0ealiasConflicts\x122\n" +
+	"\x15total_alias_conflicts\x18\x06 \x01(\x03R\x13totalAliasConflicts\x12!\n" +
+	"\fhas_previous\x18\a \x01(\bR\vhasPrevious\x124\n" +
+	"\achanges\x18\b \x03(\v2\x1a.geocoder.v1.CountryChangeR\achanges\x12#\n" +
+	"\rtotal_changes\x18\t \x01(\x03R\ftotalChanges\"\"\n" +
+	"\x10ExplainIpRequest\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\"s\n" +
+	"\rNormalizeStep\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x10\n" +
+	"\x03raw\x18\x02 \x01(\tR\x03raw\x12\x1e\n" +
+	"\n" +
+	"normalized\x18\x03 \x01(\tR\n" +
+	"normalized\x12\x1a\n" +
+	"\bselected\x18\x04 \x01(\bR\bselected\"d\n" +
+	"\vDatasetInfo\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vbuild_epoch\x18\x02 \x01(\x03R\n" +
+	"buildEpoch\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"p\n" +
+	"\n" +
+	"SourceInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\adataset\x18\x02 \x01(\v2\x18.geocoder.v1.DatasetInfoR\adataset\x12\x1a\n" +
+	"\bnetworks\x18\x03 \x01(\x03R\bnetworks\"{\n" +
+	"\x12GetDatasetResponse\x122\n" +
+	"\adataset\x18\x01 \x01(\v2\x18.geocoder.v1.DatasetInfoR\adataset\x121\n" +
+	"\asources\x18\x02 \x03(\v2\x17.geocoder.v1.SourceInfoR\asources\"\x88\x01\n" +
+	"\fOverrideInfo\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x18\n" +
+	"\aexpires\x18\x04 \x01(\x03R\aexpires\x12\x16\n" +
+	"\x06origin\x18\x05 \x01(\tR\x06origin\"\xbe\x04\n" +
+	"\x11ExplainIpResponse\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05alias\x18\x03 \x01(\tR\x05alias\x12#\n" +
+	"\rembedded_ipv4\x18\x04 \x01(\tR\fembeddedIpv4\x12#\n" +
+	"\ralias_applied\x18\x05 \x01(\bR\faliasApplied\x12%\n" +
+	"\x0elookup_address\x18\x06 \x01(\tR\rlookupAddress\x12\x14\n" +
+	"\x05found\x18\a \x01(\bR\x05found\x12\x18\n" +
+	"\anetwork\x18\b \x01(\tR\anetwork\x12\x1f\n" +
+	"\vrecord_json\x18\t \x01(\tR\n" +
+	"recordJson\x12@\n" +
+	"\rnormalization\x18\n" +
+	" \x03(\v2\x1a.geocoder.v1.NormalizeStepR\rnormalization\x12\x12\n" +
+	"\x04code\x18\v \x01(\tR\x04code\x12\x16\n" +
+	"\x06source\x18\f \x01(\tR\x06source\x122\n" +
+	"\adataset\x18\r \x01(\v2\x18.geocoder.v1.DatasetInfoR\adataset\x12)\n" +
+	"\x10database_network\x18\x0e \x01(\tR\x0fdatabaseNetwork\x12#\n" +
+	"\rdatabase_code\x18\x0f \x01(\tR\fdatabaseCode\x125\n" +
+	"\boverride\x18\x10 \x01(\v2\x19.geocoder.v1.OverrideInfoR\boverride2\xfc\t\n" +
 	"\x0fGeocoderService\x128\n" +
 	"\tGetHealth\x12\x16.google.protobuf.Empty\x1a\x13.geocoder.v1.Health\x12I\n" +
-	"\fGetCountries\x12\x16.google.protobuf.Empty\x1a!.geocoder.v1.GetCountriesResponse\x12J\n" +
+	"\fGetCountries\x12\x16.google.protobuf.Empty\x1a!.geocoder.v1.GetCountriesResponse\x12F\n" +
+	"\n" +
+	"GetCountry\x12\x1e.geocoder.v1.GetCountryRequest\x1a\x18.geocoder.v1.CountryInfo\x12J\n" +
 	"\tGetIpData\x12\x1d.geocoder.v1.GetIpDataRequest\x1a\x1e.geocoder.v1.GetIpDataResponse\x12e\n" +
 	"\x12GetCountryNetworks\x12&.geocoder.v1.GetCountryNetworksRequest\x1a'.geocoder.v1.GetCountryNetworksResponse\x12c\n" +
 	"\x17GetCountryNetworksPaged\x12+.geocoder.v1.GetCountryNetworksPagedRequest\x1a\x1b.geocoder.v1.PageDataString\x12m\n" +
-	"\x18GetCountryNetworksStream\x12,.geocoder.v1.GetCountryNetworksStreamRequest\x1a!.geocoder.v1.CountryNetworksChunk0\x01BKZIgithub.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1;geocoderv1b\x06proto3"
+	"\x18GetCountryNetworksStream\x12,.geocoder.v1.GetCountryNetworksStreamRequest\x1a!.geocoder.v1.CountryNetworksChunk0\x01\x12C\n" +
+	"\tGetGroups\x12\x16.google.protobuf.Empty\x1a\x1e.geocoder.v1.GetGroupsResponse\x12P\n" +
+	"\vGetCidrData\x12\x1f.geocoder.v1.GetCidrDataRequest\x1a .geocoder.v1.GetCidrDataResponse\x12b\n" +
+	"\x11GetRangeBreakdown\x12%.geocoder.v1.GetRangeBreakdownRequest\x1a&.geocoder.v1.GetRangeBreakdownResponse\x12e\n" +
+	"\x12EvaluateExpression\x12&.geocoder.v1.EvaluateExpressionRequest\x1a'.geocoder.v1.EvaluateExpressionResponse\x12J\n" +
+	"\tGetBogons\x12\x1d.geocoder.v1.GetBogonsRequest\x1a\x1e.geocoder.v1.GetBogonsResponse\x12E\n" +
+	"\n" +
+	"GetDataset\x12\x16.google.protobuf.Empty\x1a\x1f.geocoder.v1.GetDatasetResponse\x12T\n" +
+	"\x10GetQualityReport\x12$.geocoder.v1.GetQualityReportRequest\x1a\x1a.geocoder.v1.QualityReport\x12J\n" +
+	"\tExplainIp\x12\x1d.geocoder.v1.ExplainIpRequest\x1a\x1e.geocoder.v1.ExplainIpResponseBKZIgithub.com/Elessarov1/geocoder-go/internal/grpc/gen/geocoderv1;geocoderv1b\x06proto3"
 
 var (
 	file_geocoder_v1_geocoder_proto_rawDescOnce sync.Once
@@ -838,46 +3472,119 @@ func file_geocoder_v1_geocoder_proto_rawDescGZIP() []byte {
 	return file_geocoder_v1_geocoder_proto_rawDescData
 }
 
-var file_geocoder_v1_geocoder_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_geocoder_v1_geocoder_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_geocoder_v1_geocoder_proto_goTypes = []any{
 	(*Health)(nil),                          // 0: geocoder.v1.Health
 	(*CountryRangeData)(nil),                // 1: geocoder.v1.CountryRangeData
 	(*GetCountriesResponse)(nil),            // 2: geocoder.v1.GetCountriesResponse
-	(*IpPayload)(nil),                       // 3: geocoder.v1.IpPayload
-	(*GeoIpData)(nil),                       // 4: geocoder.v1.GeoIpData
-	(*GetIpDataRequest)(nil),                // 5: geocoder.v1.GetIpDataRequest
-	(*GetIpDataResponse)(nil),               // 6: geocoder.v1.GetIpDataResponse
-	(*IsoCodeNetworks)(nil),                 // 7: geocoder.v1.IsoCodeNetworks
-	(*GetCountryNetworksRequest)(nil),       // 8: geocoder.v1.GetCountryNetworksRequest
-	(*GetCountryNetworksResponse)(nil),      // 9: geocoder.v1.GetCountryNetworksResponse
-	(*PageDataString)(nil),                  // 10: geocoder.v1.PageDataString
-	(*GetCountryNetworksPagedRequest)(nil),  // 11: geocoder.v1.GetCountryNetworksPagedRequest
-	(*GetCountryNetworksStreamRequest)(nil), // 12: geocoder.v1.GetCountryNetworksStreamRequest
-	(*CountryNetworksChunk)(nil),            // 13: geocoder.v1.CountryNetworksChunk
-	(*emptypb.Empty)(nil),                   // 14: google.protobuf.Empty
+	(*GetCountryRequest)(nil),               // 3: geocoder.v1.GetCountryRequest
+	(*AddressSpace)(nil),                    // 4: geocoder.v1.AddressSpace
+	(*PrefixLengthCount)(nil),               // 5: geocoder.v1.PrefixLengthCount
+	(*CountryInfo)(nil),                     // 6: geocoder.v1.CountryInfo
+	(*IpPayload)(nil),                       // 7: geocoder.v1.IpPayload
+	(*GeoIpData)(nil),                       // 8: geocoder.v1.GeoIpData
+	(*SpecialBlock)(nil),                    // 9: geocoder.v1.SpecialBlock
+	(*GetIpDataRequest)(nil),                // 10: geocoder.v1.GetIpDataRequest
+	(*GetIpDataResponse)(nil),               // 11: geocoder.v1.GetIpDataResponse
+	(*IsoCodeNetworks)(nil),                 // 12: geocoder.v1.IsoCodeNetworks
+	(*GetCountryNetworksRequest)(nil),       // 13: geocoder.v1.GetCountryNetworksRequest
+	(*GetCountryNetworksResponse)(nil),      // 14: geocoder.v1.GetCountryNetworksResponse
+	(*PageDataString)(nil),                  // 15: geocoder.v1.PageDataString
+	(*GetCountryNetworksPagedRequest)(nil),  // 16: geocoder.v1.GetCountryNetworksPagedRequest
+	(*GetCountryNetworksStreamRequest)(nil), // 17: geocoder.v1.GetCountryNetworksStreamRequest
+	(*CountryNetworksChunk)(nil),            // 18: geocoder.v1.CountryNetworksChunk
+	(*NetworkData)(nil),                     // 19: geocoder.v1.NetworkData
+	(*GetCidrDataRequest)(nil),              // 20: geocoder.v1.GetCidrDataRequest
+	(*CidrData)(nil),                        // 21: geocoder.v1.CidrData
+	(*GetCidrDataResponse)(nil),             // 22: geocoder.v1.GetCidrDataResponse
+	(*GetRangeBreakdownRequest)(nil),        // 23: geocoder.v1.GetRangeBreakdownRequest
+	(*CountryShare)(nil),                    // 24: geocoder.v1.CountryShare
+	(*RangeBreakdown)(nil),                  // 25: geocoder.v1.RangeBreakdown
+	(*GetRangeBreakdownResponse)(nil),       // 26: geocoder.v1.GetRangeBreakdownResponse
+	(*EvaluateExpressionRequest)(nil),       // 27: geocoder.v1.EvaluateExpressionRequest
+	(*EvaluateExpressionResponse)(nil),      // 28: geocoder.v1.EvaluateExpressionResponse
+	(*GetBogonsRequest)(nil),                // 29: geocoder.v1.GetBogonsRequest
+	(*GetBogonsResponse)(nil),               // 30: geocoder.v1.GetBogonsResponse
+	(*CountryGroup)(nil),                    // 31: geocoder.v1.CountryGroup
+	(*GetGroupsResponse)(nil),               // 32: geocoder.v1.GetGroupsResponse
+	(*GetQualityReportRequest)(nil),         // 33: geocoder.v1.GetQualityReportRequest
+	(*NetworkIssue)(nil),                    // 34: geocoder.v1.NetworkIssue
+	(*AliasConflict)(nil),                   // 35: geocoder.v1.AliasConflict
+	(*CountryCount)(nil),                    // 36: geocoder.v1.CountryCount
+	(*CountryChange)(nil),                   // 37: geocoder.v1.CountryChange
+	(*QualityReport)(nil),                   // 38: geocoder.v1.QualityReport
+	(*ExplainIpRequest)(nil),                // 39: geocoder.v1.ExplainIpRequest
+	(*NormalizeStep)(nil),                   // 40: geocoder.v1.NormalizeStep
+	(*DatasetInfo)(nil),                     // 41: geocoder.v1.DatasetInfo
+	(*SourceInfo)(nil),                      // 42: geocoder.v1.SourceInfo
+	(*GetDatasetResponse)(nil),              // 43: geocoder.v1.GetDatasetResponse
+	(*OverrideInfo)(nil),                    // 44: geocoder.v1.OverrideInfo
+	(*ExplainIpResponse)(nil),               // 45: geocoder.v1.ExplainIpResponse
+	(*emptypb.Empty)(nil),                   // 46: google.protobuf.Empty
 }
 var file_geocoder_v1_geocoder_proto_depIdxs = []int32{
 	1,  // 0: geocoder.v1.GetCountriesResponse.countries:type_name -> geocoder.v1.CountryRangeData
-	3,  // 1: geocoder.v1.GetIpDataRequest.ips:type_name -> geocoder.v1.IpPayload
-	4,  // 2: geocoder.v1.GetIpDataResponse.items:type_name -> geocoder.v1.GeoIpData
-	7,  // 3: geocoder.v1.GetCountryNetworksResponse.items:type_name -> geocoder.v1.IsoCodeNetworks
-	14, // 4: geocoder.v1.GeocoderService.GetHealth:input_type -> google.protobuf.Empty
-	14, // 5: geocoder.v1.GeocoderService.GetCountries:input_type -> google.protobuf.Empty
-	5,  // 6: geocoder.v1.GeocoderService.GetIpData:input_type -> geocoder.v1.GetIpDataRequest
-	8,  // 7: geocoder.v1.GeocoderService.GetCountryNetworks:input_type -> geocoder.v1.GetCountryNetworksRequest
-	11, // 8: geocoder.v1.GeocoderService.GetCountryNetworksPaged:input_type -> geocoder.v1.GetCountryNetworksPagedRequest
-	12, // 9: geocoder.v1.GeocoderService.GetCountryNetworksStream:input_type -> geocoder.v1.GetCountryNetworksStreamRequest
-	0,  // 10: geocoder.v1.GeocoderService.GetHealth:output_type -> geocoder.v1.Health
-	2,  // 11: geocoder.v1.GeocoderService.GetCountries:output_type -> geocoder.v1.GetCountriesResponse
-	6,  // 12: geocoder.v1.GeocoderService.GetIpData:output_type -> geocoder.v1.GetIpDataResponse
-	9,  // 13: geocoder.v1.GeocoderService.GetCountryNetworks:output_type -> geocoder.v1.GetCountryNetworksResponse
-	10, // 14: geocoder.v1.GeocoderService.GetCountryNetworksPaged:output_type -> geocoder.v1.PageDataString
-	13, // 15: geocoder.v1.GeocoderService.GetCountryNetworksStream:output_type -> geocoder.v1.CountryNetworksChunk
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	5,  // 1: geocoder.v1.AddressSpace.prefix_lengths:type_name -> geocoder.v1.PrefixLengthCount
+	4,  // 2: geocoder.v1.CountryInfo.ipv4:type_name -> geocoder.v1.AddressSpace
+	4,  // 3: geocoder.v1.CountryInfo.ipv6:type_name -> geocoder.v1.AddressSpace
+	9,  // 4: geocoder.v1.GeoIpData.special:type_name -> geocoder.v1.SpecialBlock
+	7,  // 5: geocoder.v1.GetIpDataRequest.ips:type_name -> geocoder.v1.IpPayload
+	8,  // 6: geocoder.v1.GetIpDataResponse.items:type_name -> geocoder.v1.GeoIpData
+	12, // 7: geocoder.v1.GetCountryNetworksResponse.items:type_name -> geocoder.v1.IsoCodeNetworks
+	19, // 8: geocoder.v1.CidrData.exact:type_name -> geocoder.v1.NetworkData
+	19, // 9: geocoder.v1.CidrData.supernets:type_name -> geocoder.v1.NetworkData
+	19, // 10: geocoder.v1.CidrData.subnets:type_name -> geocoder.v1.NetworkData
+	21, // 11: geocoder.v1.GetCidrDataResponse.items:type_name -> geocoder.v1.CidrData
+	24, // 12: geocoder.v1.RangeBreakdown.countries:type_name -> geocoder.v1.CountryShare
+	25, // 13: geocoder.v1.GetRangeBreakdownResponse.items:type_name -> geocoder.v1.RangeBreakdown
+	31, // 14: geocoder.v1.GetGroupsResponse.groups:type_name -> geocoder.v1.CountryGroup
+	36, // 15: geocoder.v1.CountryChange.before:type_name -> geocoder.v1.CountryCount
+	36, // 16: geocoder.v1.CountryChange.after:type_name -> geocoder.v1.CountryCount
+	34, // 17: geocoder.v1.QualityReport.registered_differs:type_name -> geocoder.v1.NetworkIssue
+	34, // 18: geocoder.v1.QualityReport.fallbacks:type_name -> geocoder.v1.NetworkIssue
+	35, // 19: geocoder.v1.QualityReport.alias_conflicts:type_name -> geocoder.v1.AliasConflict
+	37, // 20: geocoder.v1.QualityReport.changes:type_name -> geocoder.v1.CountryChange
+	41, // 21: geocoder.v1.SourceInfo.dataset:type_name -> geocoder.v1.DatasetInfo
+	41, // 22: geocoder.v1.GetDatasetResponse.dataset:type_name -> geocoder.v1.DatasetInfo
+	42, // 23: geocoder.v1.GetDatasetResponse.sources:type_name -> geocoder.v1.SourceInfo
+	40, // 24: geocoder.v1.ExplainIpResponse.normalization:type_name -> geocoder.v1.NormalizeStep
+	41, // 25: geocoder.v1.ExplainIpResponse.dataset:type_name -> geocoder.v1.DatasetInfo
+	44, // 26: geocoder.v1.ExplainIpResponse.override:type_name -> geocoder.v1.OverrideInfo
+	46, // 27: geocoder.v1.GeocoderService.GetHealth:input_type -> google.protobuf.Empty
+	46, // 28: geocoder.v1.GeocoderService.GetCountries:input_type -> google.protobuf.Empty
+	3,  // 29: geocoder.v1.GeocoderService.GetCountry:input_type -> geocoder.v1.GetCountryRequest
+	10, // 30: geocoder.v1.GeocoderService.GetIpData:input_type -> geocoder.v1.GetIpDataRequest
+	13, // 31: geocoder.v1.GeocoderService.GetCountryNetworks:input_type -> geocoder.v1.GetCountryNetworksRequest
+	16, // 32: geocoder.v1.GeocoderService.GetCountryNetworksPaged:input_type -> geocoder.v1.GetCountryNetworksPagedRequest
+	17, // 33: geocoder.v1.GeocoderService.GetCountryNetworksStream:input_type -> geocoder.v1.GetCountryNetworksStreamRequest
+	46, // 34: geocoder.v1.GeocoderService.GetGroups:input_type -> google.protobuf.Empty
+	20, // 35: geocoder.v1.GeocoderService.GetCidrData:input_type -> geocoder.v1.GetCidrDataRequest
+	23, // 36: geocoder.v1.GeocoderService.GetRangeBreakdown:input_type -> geocoder.v1.GetRangeBreakdownRequest
+	27, // 37: geocoder.v1.GeocoderService.EvaluateExpression:input_type -> geocoder.v1.EvaluateExpressionRequest
+	29, // 38: geocoder.v1.GeocoderService.GetBogons:input_type -> geocoder.v1.GetBogonsRequest
+	46, // 39: geocoder.v1.GeocoderService.GetDataset:input_type -> google.protobuf.Empty
+	33, // 40: geocoder.v1.GeocoderService.GetQualityReport:input_type -> geocoder.v1.GetQualityReportRequest
+	39, // 41: geocoder.v1.GeocoderService.ExplainIp:input_type -> geocoder.v1.ExplainIpRequest
+	0,  // 42: geocoder.v1.GeocoderService.GetHealth:output_type -> geocoder.v1.Health
+	2,  // 43: geocoder.v1.GeocoderService.GetCountries:output_type -> geocoder.v1.GetCountriesResponse
+	6,  // 44: geocoder.v1.GeocoderService.GetCountry:output_type -> geocoder.v1.CountryInfo
+	11, // 45: geocoder.v1.GeocoderService.GetIpData:output_type -> geocoder.v1.GetIpDataResponse
+	14, // 46: geocoder.v1.GeocoderService.GetCountryNetworks:output_type -> geocoder.v1.GetCountryNetworksResponse
+	15, // 47: geocoder.v1.GeocoderService.GetCountryNetworksPaged:output_type -> geocoder.v1.PageDataString
+	18, // 48: geocoder.v1.GeocoderService.GetCountryNetworksStream:output_type -> geocoder.v1.CountryNetworksChunk
+	32, // 49: geocoder.v1.GeocoderService.GetGroups:output_type -> geocoder.v1.GetGroupsResponse
+	22, // 50: geocoder.v1.GeocoderService.GetCidrData:output_type -> geocoder.v1.GetCidrDataResponse
+	26, // 51: geocoder.v1.GeocoderService.GetRangeBreakdown:output_type -> geocoder.v1.GetRangeBreakdownResponse
+	28, // 52: geocoder.v1.GeocoderService.EvaluateExpression:output_type -> geocoder.v1.EvaluateExpressionResponse
+	30, // 53: geocoder.v1.GeocoderService.GetBogons:output_type -> geocoder.v1.GetBogonsResponse
+	43, // 54: geocoder.v1.GeocoderService.GetDataset:output_type -> geocoder.v1.GetDatasetResponse
+	38, // 55: geocoder.v1.GeocoderService.GetQualityReport:output_type -> geocoder.v1.QualityReport
+	45, // 56: geocoder.v1.GeocoderService.ExplainIp:output_type -> geocoder.v1.ExplainIpResponse
+	42, // [42:57] is the sub-list for method output_type
+	27, // [27:42] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_geocoder_v1_geocoder_proto_init() }
@@ -891,7 +3598,7 @@ func file_geocoder_v1_geocoder_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_geocoder_v1_geocoder_proto_rawDesc), len(file_geocoder_v1_geocoder_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
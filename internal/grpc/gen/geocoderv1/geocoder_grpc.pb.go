@@ -22,10 +22,19 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	GeocoderService_GetHealth_FullMethodName                = "/geocoder.v1.GeocoderService/GetHealth"
 	GeocoderService_GetCountries_FullMethodName             = "/geocoder.v1.GeocoderService/GetCountries"
+	GeocoderService_GetCountry_FullMethodName               = "/geocoder.v1.GeocoderService/GetCountry"
 	GeocoderService_GetIpData_FullMethodName                = "/geocoder.v1.GeocoderService/GetIpData"
 	GeocoderService_GetCountryNetworks_FullMethodName       = "/geocoder.v1.GeocoderService/GetCountryNetworks"
 	GeocoderService_GetCountryNetworksPaged_FullMethodName  = "/geocoder.v1.GeocoderService/GetCountryNetworksPaged"
 	GeocoderService_GetCountryNetworksStream_FullMethodName = "/geocoder.v1.GeocoderService/GetCountryNetworksStream"
+	GeocoderService_GetGroups_FullMethodName                = "/geocoder.v1.GeocoderService/GetGroups"
+	GeocoderService_GetCidrData_FullMethodName              = "/geocoder.v1.GeocoderService/GetCidrData"
+	GeocoderService_GetRangeBreakdown_FullMethodName        = "/geocoder.v1.GeocoderService/GetRangeBreakdown"
+	GeocoderService_EvaluateExpression_FullMethodName       = "/geocoder.v1.GeocoderService/EvaluateExpression"
+	GeocoderService_GetBogons_FullMethodName                = "/geocoder.v1.GeocoderService/GetBogons"
+	GeocoderService_GetDataset_FullMethodName               = "/geocoder.v1.GeocoderService/GetDataset"
+	GeocoderService_GetQualityReport_FullMethodName         = "/geocoder.v1.GeocoderService/GetQualityReport"
+	GeocoderService_ExplainIp_FullMethodName                = "/geocoder.v1.GeocoderService/ExplainIp"
 )
 
 // GeocoderServiceClient is the client API for GeocoderService service.
@@ -34,10 +43,19 @@ const (
 type GeocoderServiceClient interface {
 	GetHealth(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Health, error)
 	GetCountries(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCountriesResponse, error)
+	GetCountry(ctx context.Context, in *GetCountryRequest, opts ...grpc.CallOption) (*CountryInfo, error)
 	GetIpData(ctx context.Context, in *GetIpDataRequest, opts ...grpc.CallOption) (*GetIpDataResponse, error)
 	GetCountryNetworks(ctx context.Context, in *GetCountryNetworksRequest, opts ...grpc.CallOption) (*GetCountryNetworksResponse, error)
 	GetCountryNetworksPaged(ctx context.Context, in *GetCountryNetworksPagedRequest, opts ...grpc.CallOption) (*PageDataString, error)
 	GetCountryNetworksStream(ctx context.Context, in *GetCountryNetworksStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CountryNetworksChunk], error)
+	GetGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGroupsResponse, error)
+	GetCidrData(ctx context.Context, in *GetCidrDataRequest, opts ...grpc.CallOption) (*GetCidrDataResponse, error)
+	GetRangeBreakdown(ctx context.Context, in *GetRangeBreakdownRequest, opts ...grpc.CallOption) (*GetRangeBreakdownResponse, error)
+	EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error)
+	GetBogons(ctx context.Context, in *GetBogonsRequest, opts ...grpc.CallOption) (*GetBogonsResponse, error)
+	GetDataset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDatasetResponse, error)
+	GetQualityReport(ctx context.Context, in *GetQualityReportRequest, opts ...grpc.CallOption) (*QualityReport, error)
+	ExplainIp(ctx context.Context, in *ExplainIpRequest, opts ...grpc.CallOption) (*ExplainIpResponse, error)
 }
 
 type geocoderServiceClient struct {
@@ -68,6 +86,16 @@ func (c *geocoderServiceClient) GetCountries(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *geocoderServiceClient) GetCountry(ctx context.Context, in *GetCountryRequest, opts ...grpc.CallOption) (*CountryInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountryInfo)
+	err := c.cc.Invoke(ctx, GeocoderService_GetCountry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *geocoderServiceClient) GetIpData(ctx context.Context, in *GetIpDataRequest, opts ...grpc.CallOption) (*GetIpDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetIpDataResponse)
@@ -117,16 +145,105 @@ func (c *geocoderServiceClient) GetCountryNetworksStream(ctx context.Context, in
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GeocoderService_GetCountryNetworksStreamClient = grpc.ServerStreamingClient[CountryNetworksChunk]
 
+func (c *geocoderServiceClient) GetGroups(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_GetGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) GetCidrData(ctx context.Context, in *GetCidrDataRequest, opts ...grpc.CallOption) (*GetCidrDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCidrDataResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_GetCidrData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) GetRangeBreakdown(ctx context.Context, in *GetRangeBreakdownRequest, opts ...grpc.CallOption) (*GetRangeBreakdownResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRangeBreakdownResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_GetRangeBreakdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) EvaluateExpression(ctx context.Context, in *EvaluateExpressionRequest, opts ...grpc.CallOption) (*EvaluateExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateExpressionResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_EvaluateExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) GetBogons(ctx context.Context, in *GetBogonsRequest, opts ...grpc.CallOption) (*GetBogonsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBogonsResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_GetBogons_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) GetDataset(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDatasetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDatasetResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_GetDataset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) GetQualityReport(ctx context.Context, in *GetQualityReportRequest, opts ...grpc.CallOption) (*QualityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QualityReport)
+	err := c.cc.Invoke(ctx, GeocoderService_GetQualityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geocoderServiceClient) ExplainIp(ctx context.Context, in *ExplainIpRequest, opts ...grpc.CallOption) (*ExplainIpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExplainIpResponse)
+	err := c.cc.Invoke(ctx, GeocoderService_ExplainIp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GeocoderServiceServer is the server API for GeocoderService service.
 // All implementations must embed UnimplementedGeocoderServiceServer
 // for forward compatibility.
 type GeocoderServiceServer interface {
 	GetHealth(context.Context, *emptypb.Empty) (*Health, error)
 	GetCountries(context.Context, *emptypb.Empty) (*GetCountriesResponse, error)
+	GetCountry(context.Context, *GetCountryRequest) (*CountryInfo, error)
 	GetIpData(context.Context, *GetIpDataRequest) (*GetIpDataResponse, error)
 	GetCountryNetworks(context.Context, *GetCountryNetworksRequest) (*GetCountryNetworksResponse, error)
 	GetCountryNetworksPaged(context.Context, *GetCountryNetworksPagedRequest) (*PageDataString, error)
 	GetCountryNetworksStream(*GetCountryNetworksStreamRequest, grpc.ServerStreamingServer[CountryNetworksChunk]) error
+	GetGroups(context.Context, *emptypb.Empty) (*GetGroupsResponse, error)
+	GetCidrData(context.Context, *GetCidrDataRequest) (*GetCidrDataResponse, error)
+	GetRangeBreakdown(context.Context, *GetRangeBreakdownRequest) (*GetRangeBreakdownResponse, error)
+	EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error)
+	GetBogons(context.Context, *GetBogonsRequest) (*GetBogonsResponse, error)
+	GetDataset(context.Context, *emptypb.Empty) (*GetDatasetResponse, error)
+	GetQualityReport(context.Context, *GetQualityReportRequest) (*QualityReport, error)
+	ExplainIp(context.Context, *ExplainIpRequest) (*ExplainIpResponse, error)
 	mustEmbedUnimplementedGeocoderServiceServer()
 }
 
@@ -143,6 +260,9 @@ func (UnimplementedGeocoderServiceServer) GetHealth(context.Context, *emptypb.Em
 func (UnimplementedGeocoderServiceServer) GetCountries(context.Context, *emptypb.Empty) (*GetCountriesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCountries not implemented")
 }
+func (UnimplementedGeocoderServiceServer) GetCountry(context.Context, *GetCountryRequest) (*CountryInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCountry not implemented")
+}
 func (UnimplementedGeocoderServiceServer) GetIpData(context.Context, *GetIpDataRequest) (*GetIpDataResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetIpData not implemented")
 }
@@ -155,6 +275,30 @@ func (UnimplementedGeocoderServiceServer) GetCountryNetworksPaged(context.Contex
 func (UnimplementedGeocoderServiceServer) GetCountryNetworksStream(*GetCountryNetworksStreamRequest, grpc.ServerStreamingServer[CountryNetworksChunk]) error {
 	return status.Error(codes.Unimplemented, "method GetCountryNetworksStream not implemented")
 }
+func (UnimplementedGeocoderServiceServer) GetGroups(context.Context, *emptypb.Empty) (*GetGroupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGroups not implemented")
+}
+func (UnimplementedGeocoderServiceServer) GetCidrData(context.Context, *GetCidrDataRequest) (*GetCidrDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCidrData not implemented")
+}
+func (UnimplementedGeocoderServiceServer) GetRangeBreakdown(context.Context, *GetRangeBreakdownRequest) (*GetRangeBreakdownResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRangeBreakdown not implemented")
+}
+func (UnimplementedGeocoderServiceServer) EvaluateExpression(context.Context, *EvaluateExpressionRequest) (*EvaluateExpressionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EvaluateExpression not implemented")
+}
+func (UnimplementedGeocoderServiceServer) GetBogons(context.Context, *GetBogonsRequest) (*GetBogonsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBogons not implemented")
+}
+func (UnimplementedGeocoderServiceServer) GetDataset(context.Context, *emptypb.Empty) (*GetDatasetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataset not implemented")
+}
+func (UnimplementedGeocoderServiceServer) GetQualityReport(context.Context, *GetQualityReportRequest) (*QualityReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQualityReport not implemented")
+}
+func (UnimplementedGeocoderServiceServer) ExplainIp(context.Context, *ExplainIpRequest) (*ExplainIpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExplainIp not implemented")
+}
 func (UnimplementedGeocoderServiceServer) mustEmbedUnimplementedGeocoderServiceServer() {}
 func (UnimplementedGeocoderServiceServer) testEmbeddedByValue()                         {}
 
@@ -212,6 +356,24 @@ func _GeocoderService_GetCountries_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GeocoderService_GetCountry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCountryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetCountry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetCountry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetCountry(ctx, req.(*GetCountryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GeocoderService_GetIpData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetIpDataRequest)
 	if err := dec(in); err != nil {
@@ -277,6 +439,150 @@ func _GeocoderService_GetCountryNetworksStream_Handler(srv interface{}, stream g
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type GeocoderService_GetCountryNetworksStreamServer = grpc.ServerStreamingServer[CountryNetworksChunk]
 
+func _GeocoderService_GetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetGroups(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_GetCidrData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCidrDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetCidrData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetCidrData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetCidrData(ctx, req.(*GetCidrDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_GetRangeBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRangeBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetRangeBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetRangeBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetRangeBreakdown(ctx, req.(*GetRangeBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_EvaluateExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).EvaluateExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_EvaluateExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).EvaluateExpression(ctx, req.(*EvaluateExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_GetBogons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBogonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetBogons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetBogons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetBogons(ctx, req.(*GetBogonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_GetDataset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetDataset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetDataset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetDataset(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_GetQualityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQualityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).GetQualityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_GetQualityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).GetQualityReport(ctx, req.(*GetQualityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GeocoderService_ExplainIp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainIpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeocoderServiceServer).ExplainIp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GeocoderService_ExplainIp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeocoderServiceServer).ExplainIp(ctx, req.(*ExplainIpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GeocoderService_ServiceDesc is the grpc.ServiceDesc for GeocoderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -292,6 +598,10 @@ var GeocoderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCountries",
 			Handler:    _GeocoderService_GetCountries_Handler,
 		},
+		{
+			MethodName: "GetCountry",
+			Handler:    _GeocoderService_GetCountry_Handler,
+		},
 		{
 			MethodName: "GetIpData",
 			Handler:    _GeocoderService_GetIpData_Handler,
@@ -304,6 +614,38 @@ var GeocoderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCountryNetworksPaged",
 			Handler:    _GeocoderService_GetCountryNetworksPaged_Handler,
 		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _GeocoderService_GetGroups_Handler,
+		},
+		{
+			MethodName: "GetCidrData",
+			Handler:    _GeocoderService_GetCidrData_Handler,
+		},
+		{
+			MethodName: "GetRangeBreakdown",
+			Handler:    _GeocoderService_GetRangeBreakdown_Handler,
+		},
+		{
+			MethodName: "EvaluateExpression",
+			Handler:    _GeocoderService_EvaluateExpression_Handler,
+		},
+		{
+			MethodName: "GetBogons",
+			Handler:    _GeocoderService_GetBogons_Handler,
+		},
+		{
+			MethodName: "GetDataset",
+			Handler:    _GeocoderService_GetDataset_Handler,
+		},
+		{
+			MethodName: "GetQualityReport",
+			Handler:    _GeocoderService_GetQualityReport_Handler,
+		},
+		{
+			MethodName: "ExplainIp",
+			Handler:    _GeocoderService_ExplainIp_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,58 @@
+// Package ratelimit provides the token buckets and concurrency limits behind
+// per-key quotas and the limits on heavy operations.
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Bucket is a token bucket: it holds up to burst tokens and refills at rate
+// tokens per second. A request takes one token.
+type Bucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewBucket returns a full bucket; rate must be positive. A burst below 1
+// is raised to the rate rounded up (and at least 1).
+func NewBucket(rate float64, burst int) *Bucket {
+	b := float64(burst)
+	if b < 1 {
+		b = math.Max(1, math.Ceil(rate))
+	}
+	return &Bucket{rate: rate, burst: b, tokens: b}
+}
+
+// Take takes a token at now. When the bucket is empty it reports how long
+// until the next token is available instead.
+func (b *Bucket) Take(now time.Time) (ok bool, retryAfter time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	if b.last.IsZero() || now.After(b.last) {
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// full reports whether the bucket has refilled completely by now, so it
+// behaves like a new one.
+func (b *Bucket) full(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
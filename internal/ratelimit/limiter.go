@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// heavyOperations are the operations that build large responses: full
+// network lists, streams and exports, and subnet listings of short CIDRs.
+// OpenAPI operation IDs and gRPC method names are looked up lower-cased.
+var heavyOperations = map[string]bool{
+	"getcidrdata":              true,
+	"getcountrynetworks":       true,
+	"getcountrynetworksstream": true,
+	"evaluateexpression":       true,
+	"getrangebreakdown":        true,
+	"getqualityreport":         true,
+}
+
+// Heavy reports whether an OpenAPI operation ID or gRPC method name is
+// subject to the heavy operation limits.
+func Heavy(operation string) bool {
+	return heavyOperations[strings.ToLower(operation)]
+}
+
+// LimitError is returned for a request rejected by a Limiter.
+type LimitError struct {
+	Msg        string
+	RetryAfter time.Duration
+	// InFlight tells a rejection by the in-flight limit from one by the
+	// client's rate.
+	InFlight bool
+}
+
+func (e *LimitError) Error() string {
+	return e.Msg
+}
+
+// RetryAfterSeconds is RetryAfter in whole seconds, at least 1, as sent in
+// Retry-After.
+func (e *LimitError) RetryAfterSeconds() int {
+	return int(math.Max(1, math.Ceil(e.RetryAfter.Seconds())))
+}
+
+// inFlightRetry is the Retry-After hint of requests rejected because too
+// many heavy requests are running.
+const inFlightRetry = time.Second
+
+// sweepEvery is the number of bucket lookups between sweeps of the
+// client buckets.
+const sweepEvery = 1024
+
+// Limiter admits heavy requests: each client gets a token bucket of rate
+// requests per second, and at most maxInFlight requests run at once across
+// all clients. A zero rate or maxInFlight disables that limit.
+type Limiter struct {
+	rate  float64
+	burst int
+
+	inFlight chan struct{}
+
+	mu      sync.Mutex
+	clients map[string]*Bucket
+	lookups int
+}
+
+func NewLimiter(rate float64, burst, maxInFlight int) *Limiter {
+	l := &Limiter{rate: rate, burst: burst, clients: make(map[string]*Bucket)}
+	if maxInFlight > 0 {
+		l.inFlight = make(chan struct{}, maxInFlight)
+	}
+	return l
+}
+
+// Acquire admits a request of client at now. The returned release must be
+// called when the request is done; it is nil when the request is rejected.
+func (l *Limiter) Acquire(client string, now time.Time) (release func(), err error) {
+	release = func() {}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		default:
+			return nil, &LimitError{
+				Msg:        fmt.Sprintf("too many heavy requests in flight (limit %d)", cap(l.inFlight)),
+				RetryAfter: inFlightRetry,
+				InFlight:   true,
+			}
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-l.inFlight }) }
+	}
+
+	if l.rate > 0 {
+		if ok, wait := l.bucket(client, now).Take(now); !ok {
+			release()
+			return nil, &LimitError{
+				Msg:        fmt.Sprintf("client %s is over the rate limit of heavy requests", client),
+				RetryAfter: wait,
+			}
+		}
+	}
+	return release, nil
+}
+
+// InFlight returns the number of heavy requests running.
+func (l *Limiter) InFlight() int {
+	return len(l.inFlight)
+}
+
+func (l *Limiter) bucket(client string, now time.Time) *Bucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Refilled buckets are dropped now and then, so idle clients do not
+	// pile up.
+	if l.lookups++; l.lookups >= sweepEvery {
+		l.lookups = 0
+		for c, b := range l.clients {
+			if b.full(now) {
+				delete(l.clients, c)
+			}
+		}
+	}
+
+	b, ok := l.clients[client]
+	if !ok {
+		b = NewBucket(l.rate, l.burst)
+		l.clients[client] = b
+	}
+	return b
+}
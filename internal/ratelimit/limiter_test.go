@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestHeavy(t *testing.T) {
+	for _, op := range []string{"GetCountryNetworks", "getcidrdata", "GetCidrData", "EvaluateExpression"} {
+		if !Heavy(op) {
+			t.Errorf("Heavy(%q) = false", op)
+		}
+	}
+	for _, op := range []string{"GetIpData", "GetHealth", ""} {
+		if Heavy(op) {
+			t.Errorf("Heavy(%q) = true", op)
+		}
+	}
+}
+
+func TestAcquireInFlight(t *testing.T) {
+	l := NewLimiter(1, 1, 1)
+
+	release, err := l.Acquire("a", t0)
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+
+	// Rejected for the in-flight limit: b's token must stay in its bucket.
+	_, err = l.Acquire("b", t0)
+	var le *LimitError
+	if !errors.As(err, &le) || !le.InFlight {
+		t.Fatalf("second request: err = %v, want an in-flight LimitError", err)
+	}
+	if le.RetryAfter != inFlightRetry || le.RetryAfterSeconds() != 1 {
+		t.Errorf("retry after %s (%ds), want %s", le.RetryAfter, le.RetryAfterSeconds(), inFlightRetry)
+	}
+
+	release()
+	release() // idempotent: must not free a slot held by another request
+	if n := l.InFlight(); n != 0 {
+		t.Fatalf("InFlight() = %d after release, want 0", n)
+	}
+
+	releaseB, err := l.Acquire("b", t0)
+	if err != nil {
+		t.Fatalf("b after the slot was freed: %v (its token was taken by the rejection)", err)
+	}
+	if _, err := l.Acquire("c", t0); err == nil {
+		t.Fatal("c admitted while b runs; a's second release freed b's slot")
+	}
+	releaseB()
+}
+
+func TestAcquireRate(t *testing.T) {
+	l := NewLimiter(0.5, 2, 0)
+
+	for i := range 2 {
+		release, err := l.Acquire("a", t0)
+		if err != nil {
+			t.Fatalf("request %d within burst: %v", i, err)
+		}
+		release()
+	}
+
+	_, err := l.Acquire("a", t0.Add(500*time.Millisecond))
+	var le *LimitError
+	if !errors.As(err, &le) || le.InFlight {
+		t.Fatalf("err = %v, want a rate LimitError", err)
+	}
+	// 0.25 of a token refilled, 0.75 to go at 0.5 per second.
+	if le.RetryAfter != 1500*time.Millisecond || le.RetryAfterSeconds() != 2 {
+		t.Errorf("retry after %s (%ds), want 1.5s (2s)", le.RetryAfter, le.RetryAfterSeconds())
+	}
+
+	// Clients have buckets of their own.
+	if _, err := l.Acquire("b", t0); err != nil {
+		t.Errorf("other client: %v", err)
+	}
+	if _, err := l.Acquire("a", t0.Add(2*time.Second)); err != nil {
+		t.Errorf("after refill: %v", err)
+	}
+}
+
+func TestAcquireRateReleasesSlot(t *testing.T) {
+	l := NewLimiter(1, 1, 1)
+	release, _ := l.Acquire("a", t0)
+	release()
+
+	if _, err := l.Acquire("a", t0); err == nil {
+		t.Fatal("second request within the same second admitted")
+	}
+	if n := l.InFlight(); n != 0 {
+		t.Errorf("InFlight() = %d after a rate rejection, want 0", n)
+	}
+}
+
+func TestBucketSweep(t *testing.T) {
+	l := NewLimiter(1, 1, 0)
+
+	// idle refills after a second; busy is drained again at the end.
+	l.bucket("idle", t0).Take(t0)
+	busy := l.bucket("busy", t0)
+	busy.Take(t0)
+
+	later := t0.Add(10 * time.Second)
+	busy.Take(later)
+	for i := range sweepEvery {
+		l.bucket(fmt.Sprintf("client%d", i), later)
+	}
+
+	if _, ok := l.clients["idle"]; ok {
+		t.Errorf("idle client kept after %d lookups", sweepEvery)
+	}
+	if l.clients["busy"] != busy {
+		t.Error("drained bucket swept; its client would get a full one back")
+	}
+}
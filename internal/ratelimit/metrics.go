@@ -0,0 +1,30 @@
+package ratelimit
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var rejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "geocoder_heavy_rejections_total",
+	Help: "Heavy requests rejected by transport and limit (rate or in_flight).",
+}, []string{"transport", "limit"})
+
+func init() {
+	prometheus.MustRegister(rejections)
+}
+
+// Observe counts a request rejected by a Limiter on transport (http or
+// grpc); other errors are ignored.
+func Observe(transport string, err error) {
+	var le *LimitError
+	if !errors.As(err, &le) {
+		return
+	}
+	limit := "rate"
+	if le.InFlight {
+		limit = "in_flight"
+	}
+	rejections.WithLabelValues(transport, limit).Inc()
+}
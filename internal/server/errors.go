@@ -36,6 +36,11 @@ func (h *GeoCoderHandler) toOASError(_ context.Context, err error) *oas.DefaultE
 		return ErrResponse(http.StatusBadRequest, "geo.bad_request", ia.Error())
 	}
 
+	var fb *geocoder_api.ForbiddenError
+	if errors.As(err, &fb) {
+		return ErrResponse(http.StatusForbidden, "geo.forbidden", fb.Error())
+	}
+
 	var nf *geocoder_api.NotFoundError
 	if errors.As(err, &nf) {
 		return ErrResponse(http.StatusNotFound, "geo.not_found", nf.Error())
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// GET /geo/bogons?family=ipv4&format=nftables
+func (h *GeoCoderHandler) GetBogons(ctx context.Context, params oas.GetBogonsParams) (oas.GetBogonsRes, error) {
+	res, err := h.api.GetBogons(
+		ctx,
+		string(params.Family),
+		string(params.Format.Or(oas.ExportFormatJSON)),
+		params.Name.Or(""),
+	)
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	if res.Export != nil {
+		return &oas.GetBogonsOKTextPlain{Data: bytes.NewReader(res.Export)}, nil
+	}
+
+	networks := make([]oas.Cidr, len(res.Networks))
+	for i, p := range res.Networks {
+		networks[i] = oas.Cidr(p.String())
+	}
+	return &oas.BogonList{
+		Family:        oas.AddressFamily(res.Family),
+		Networks:      networks,
+		TotalNetworks: int64(len(networks)),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// POST /geo/breakdown
+func (h *GeoCoderHandler) GetRangeBreakdown(ctx context.Context, req *oas.BreakdownPayload) (oas.GetRangeBreakdownRes, error) {
+	if req == nil {
+		return nil, ErrResponse(http.StatusBadRequest, "geo.bad_request", "request body is required")
+	}
+
+	items, err := h.api.GetRangeBreakdown(ctx, req.Ranges)
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	out := make([]oas.RangeBreakdown, 0, len(items))
+	for _, it := range items {
+		item := oas.RangeBreakdown{
+			Query:     it.Query,
+			First:     oas.IpAddress(it.Range.First.String()),
+			Last:      oas.IpAddress(it.Range.Last.String()),
+			Addresses: oas.AddressCount(it.Addresses.String()),
+			Countries: make([]oas.CountryShare, 0, len(it.Countries)),
+			Unallocated: oas.UnallocatedShare{
+				Ranges:    make([]string, 0, len(it.Unallocated)),
+				Addresses: oas.AddressCount(it.UnallocatedAddresses.String()),
+				Percent:   it.UnallocatedPercent,
+			},
+		}
+		for _, c := range it.Countries {
+			networks := make([]oas.Cidr, len(c.Networks))
+			for i, p := range c.Networks {
+				networks[i] = oas.Cidr(p.String())
+			}
+			item.Countries = append(item.Countries, oas.CountryShare{
+				Code:      oas.IsoCode(c.Code),
+				Networks:  networks,
+				Addresses: oas.AddressCount(c.Addresses.String()),
+				Percent:   c.Percent,
+			})
+		}
+		for _, r := range it.Unallocated {
+			item.Unallocated.Ranges = append(item.Unallocated.Ranges, r.String())
+		}
+		out = append(out, item)
+	}
+
+	ok := oas.GetRangeBreakdownOKApplicationJSON(out)
+	return &ok, nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// POST /geo/cidr_data
+func (h *GeoCoderHandler) GetCidrData(ctx context.Context, req *oas.CidrPayload) (oas.GetCidrDataRes, error) {
+	if req == nil {
+		return nil, ErrResponse(http.StatusBadRequest, "geo.bad_request", "request body is required")
+	}
+
+	cidrs := make([]string, 0, len(req.Cidrs))
+	for _, c := range req.Cidrs {
+		cidrs = append(cidrs, string(c))
+	}
+
+	items, err := h.api.GetCidrData(ctx, cidrs, int(req.Page.Or(0)), int(req.Size.Or(1000)))
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	out := make([]oas.CidrData, 0, len(items))
+	for _, it := range items {
+		item := oas.CidrData{
+			Cidr:         oas.Cidr(it.CIDR.String()),
+			Supernets:    toOASNetworkData(it.Supernets),
+			Subnets:      toOASNetworkData(it.Subnets),
+			TotalSubnets: int64(it.TotalSubnets),
+			TotalPages:   int64(it.TotalPages),
+			Size:         int64(it.Size),
+			Page:         int64(it.Page),
+		}
+		if it.Exact != nil {
+			item.Exact = oas.NewOptNetworkData(toOASNetwork(*it.Exact))
+		}
+		out = append(out, item)
+	}
+
+	ok := oas.GetCidrDataOKApplicationJSON(out)
+	return &ok, nil
+}
+
+func toOASNetworkData(in []geocoder_api.NetworkData) []oas.NetworkData {
+	out := make([]oas.NetworkData, len(in))
+	for i, n := range in {
+		out[i] = toOASNetwork(n)
+	}
+	return out
+}
+
+func toOASNetwork(n geocoder_api.NetworkData) oas.NetworkData {
+	return oas.NetworkData{
+		Network:    oas.Cidr(n.Network.String()),
+		Code:       oas.IsoCode(n.Code),
+		Source:     oas.CodeSource(n.Source),
+		Provenance: oas.Provenance(n.Provenance),
+	}
+}
@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"math"
 
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
 	"github.com/Elessarov1/geocoder-go/internal/server/oas"
 )
 
@@ -16,11 +18,58 @@ func (h *GeoCoderHandler) GetCountries(ctx context.Context) (oas.GetCountriesRes
 	out := make([]oas.CountryRangeData, 0, len(items))
 	for _, it := range items {
 		out = append(out, oas.CountryRangeData{
-			Code:        oas.IsoCode(it.Code),
-			RangesCount: int32(it.RangesCount),
+			Code:          oas.IsoCode(it.Code),
+			RangesCount:   int32(it.RangesCount),
+			Ipv4Addresses: int64(it.IPv4Addresses),
+			Ipv6Slash64s:  int64(min(it.IPv6Slash64s, math.MaxInt64)),
+			Ipv4Percent:   it.IPv4Percent,
+			Ipv6Percent:   it.IPv6Percent,
 		})
 	}
 
 	ok := oas.GetCountriesOKApplicationJSON(out)
 	return &ok, nil
 }
+
+// GET /geo/countries/{code}
+func (h *GeoCoderHandler) GetCountry(ctx context.Context, params oas.GetCountryParams) (oas.GetCountryRes, error) {
+	c, err := h.api.GetCountry(ctx, string(params.Code))
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	return &oas.CountryInfo{
+		Code:      oas.IsoCode(c.Code),
+		Alpha3:    optString(c.Alpha3),
+		Numeric:   optString(c.Numeric),
+		Name:      optString(c.Name),
+		Continent: optString(c.Continent),
+		Region:    optString(c.Region),
+		Ipv4:      toOASAddressSpace(c.IPv4, false),
+		Ipv6:      toOASAddressSpace(c.IPv6, true),
+	}, nil
+}
+
+func toOASAddressSpace(s geocoder_api.AddressSpace, ipv6 bool) oas.AddressSpace {
+	out := oas.AddressSpace{
+		Networks:      int32(s.Networks),
+		Addresses:     oas.AddressCount(s.Addresses.String()),
+		Percent:       s.Percent,
+		PrefixLengths: make([]oas.PrefixLengthCount, len(s.Prefixes)),
+	}
+	if ipv6 {
+		out.Slash64s = oas.NewOptInt64(int64(min(s.Slash64s, math.MaxInt64)))
+	}
+	for i, p := range s.Prefixes {
+		out.PrefixLengths[i] = oas.PrefixLengthCount{Bits: int32(p.Bits), Networks: int32(p.Networks)}
+	}
+	return out
+}
+
+// optString leaves empty strings unset.
+func optString(v string) oas.OptString {
+	if v == "" {
+		return oas.OptString{}
+	}
+	return oas.NewOptString(v)
+}
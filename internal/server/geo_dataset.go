@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// GET /geo/dataset
+func (h *GeoCoderHandler) GetDataset(ctx context.Context) (oas.GetDatasetRes, error) {
+	md, err := h.api.GetDataset(ctx)
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	resp := oas.DatasetMetadata{
+		Dataset: toOASDatasetInfo(md.Dataset),
+		Sources: make([]oas.SourceInfo, 0, len(md.Sources)),
+	}
+	for _, src := range md.Sources {
+		item := oas.SourceInfo{
+			Name:      oas.Provenance(src.Name),
+			Type:      src.Dataset.Type,
+			BuildTime: src.Dataset.BuildTime,
+			Networks:  int64(src.Networks),
+		}
+		if src.Dataset.Description != "" {
+			item.Description = oas.NewOptString(src.Dataset.Description)
+		}
+		resp.Sources = append(resp.Sources, item)
+	}
+
+	return &resp, nil
+}
+
+func toOASDatasetInfo(ds geocoder_api.DatasetInfo) oas.DatasetInfo {
+	out := oas.DatasetInfo{
+		Type:      ds.Type,
+		BuildTime: ds.BuildTime,
+	}
+	if ds.Description != "" {
+		out.Description = oas.NewOptString(ds.Description)
+	}
+	return out
+}
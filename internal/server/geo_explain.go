@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+
+	"github.com/go-faster/jx"
+)
+
+// GET /geo/explain?ip=8.8.8.8
+func (h *GeoCoderHandler) ExplainIp(ctx context.Context, params oas.ExplainIpParams) (oas.ExplainIpRes, error) {
+	ex, err := h.api.ExplainIp(ctx, strings.TrimSpace(string(params.IP)))
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	steps := make([]oas.NormalizeStep, 0, len(ex.Steps))
+	for _, st := range ex.Steps {
+		steps = append(steps, oas.NormalizeStep{
+			Field:      st.Field,
+			Raw:        st.Raw,
+			Normalized: st.Normalized,
+			Selected:   st.Selected,
+		})
+	}
+
+	resp := oas.IpExplanation{
+		IP:      oas.IpAddress(ex.IP),
+		Address: oas.IpAddress(ex.Address.String()),
+		Alias: oas.AliasInfo{
+			Kind:    oas.AliasInfoKind(ex.Alias),
+			Applied: ex.AliasApplied,
+		},
+		LookupAddress: oas.IpAddress(ex.LookupAddress.String()),
+		Found:         ex.Found,
+		DatabaseCode:  oas.IsoCode(ex.DatabaseCode),
+		Normalization: steps,
+		Code:          oas.IsoCode(ex.Code),
+		Source:        oas.CodeSource(ex.Source),
+		Dataset:       toOASDatasetInfo(ex.Dataset),
+	}
+	if ex.EmbeddedIPv4.IsValid() {
+		resp.Alias.EmbeddedIpv4 = oas.NewOptIpAddress(oas.IpAddress(ex.EmbeddedIPv4.String()))
+	}
+	if ex.Network.IsValid() {
+		resp.Network = oas.NewOptCidr(oas.Cidr(ex.Network.String()))
+	}
+	if ex.DatabaseNetwork.IsValid() {
+		resp.DatabaseNetwork = oas.NewOptCidr(oas.Cidr(ex.DatabaseNetwork.String()))
+	}
+	if o := ex.Override; o != nil {
+		info := oas.OverrideInfo{
+			Network: oas.Cidr(o.Network.String()),
+			Code:    oas.IsoCode(o.Code),
+			Origin:  o.Origin,
+		}
+		if o.Comment != "" {
+			info.Comment = oas.NewOptString(o.Comment)
+		}
+		if !o.Expires.IsZero() {
+			info.Expires = oas.NewOptDateTime(o.Expires)
+		}
+		resp.Override = oas.NewOptOverrideInfo(info)
+	}
+	if ex.Record != nil {
+		rec := make(oas.IpExplanationRecord, len(ex.Record))
+		for k, v := range ex.Record {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, h.toOASError(ctx, err)
+			}
+			rec[k] = jx.Raw(b)
+		}
+		resp.Record = oas.NewOptIpExplanationRecord(rec)
+	}
+
+	return &resp, nil
+}
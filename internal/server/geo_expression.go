@@ -0,0 +1,35 @@
+package server
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// GET /geo/expression?expression=EU%20%2B%20CH&format=nginx
+func (h *GeoCoderHandler) EvaluateExpression(ctx context.Context, params oas.EvaluateExpressionParams) (oas.EvaluateExpressionRes, error) {
+	res, err := h.api.EvaluateExpression(
+		ctx,
+		params.Expression,
+		string(params.Format.Or(oas.ExportFormatJSON)),
+		params.Name.Or(""),
+	)
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	if res.Export != nil {
+		return &oas.EvaluateExpressionOKTextPlain{Data: bytes.NewReader(res.Export)}, nil
+	}
+
+	networks := make([]oas.Cidr, len(res.Networks))
+	for i, p := range res.Networks {
+		networks[i] = oas.Cidr(p.String())
+	}
+	return &oas.ExpressionResult{
+		Expression:    res.Expression,
+		Networks:      networks,
+		TotalNetworks: int64(len(networks)),
+	}, nil
+}
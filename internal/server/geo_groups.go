@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// GET /geo/groups
+func (h *GeoCoderHandler) GetGroups(ctx context.Context) (oas.GetGroupsRes, error) {
+	groups, err := h.api.GetGroups(ctx)
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	out := make([]oas.CountryGroup, 0, len(groups))
+	for _, g := range groups {
+		members := make([]oas.IsoCode, len(g.Members))
+		for i, m := range g.Members {
+			members[i] = oas.IsoCode(m)
+		}
+		out = append(out, oas.CountryGroup{
+			ID:      g.ID,
+			Name:    g.Name,
+			Kind:    oas.CountryGroupKind(g.Kind),
+			Members: members,
+		})
+	}
+
+	ok := oas.GetGroupsOKApplicationJSON(out)
+	return &ok, nil
+}
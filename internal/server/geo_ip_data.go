@@ -16,7 +16,7 @@ func (h *GeoCoderHandler) GetIpData(ctx context.Context, req *oas.GeoPayload) (o
 
 	ips := make([]string, 0, len(req.Ips))
 	for _, item := range req.Ips {
-		ipStr := strings.TrimSpace(string(item.IP))
+		ipStr := strings.TrimSpace(item.IP)
 		if ipStr != "" {
 			ips = append(ips, ipStr)
 		}
@@ -26,17 +26,48 @@ func (h *GeoCoderHandler) GetIpData(ctx context.Context, req *oas.GeoPayload) (o
 		return nil, ErrResponse(http.StatusBadRequest, "geo.bad_request", "ips must not be empty")
 	}
 
-	items, err := h.api.GetIpData(ctx, ips)
+	items, err := h.api.GetIpData(ctx, ips, string(req.Basis.Or(oas.BasisLocated)))
 	if err != nil {
 		return nil, h.toOASError(ctx, err)
 	}
 
+	withProvenance := req.Provenance.Or(false)
+
 	out := make([]oas.GeoIpData, 0, len(items))
 	for _, it := range items {
-		out = append(out, oas.GeoIpData{
-			IP:   oas.IpAddress(it.IP),
-			Code: oas.IsoCode(it.Code),
-		})
+		item := oas.GeoIpData{
+			IP:                       it.IP,
+			NormalizedIp:             oas.IpAddress(it.NormalizedIP.String()),
+			Code:                     oas.IsoCode(it.Code),
+			Source:                   oas.CodeSource(it.Source),
+			RegisteredCountryDiffers: it.RegisteredDiffers,
+			AddressClass:             oas.AddressClass(it.AddressClass),
+			Bogon:                    it.Bogon,
+		}
+		if it.CountryName != "" {
+			item.CountryName = oas.NewOptNilString(it.CountryName)
+		}
+		if it.Network.IsValid() {
+			item.Network = oas.NewOptCidr(oas.Cidr(it.Network.String()))
+		}
+		if it.RegisteredCode != "" {
+			item.RegisteredCode = oas.NewOptIsoCode(oas.IsoCode(it.RegisteredCode))
+		}
+		if withProvenance && it.Provenance != "" {
+			item.Provenance = oas.NewOptProvenance(oas.Provenance(it.Provenance))
+		}
+		if it.Special != nil {
+			item.Special = oas.NewOptSpecialBlock(oas.SpecialBlock{
+				Network: oas.Cidr(it.Special.Network.String()),
+				Name:    it.Special.Name,
+				Rfc:     it.Special.RFC,
+			})
+		}
+		if it.Alias != "" {
+			item.Alias = oas.NewOptGeoIpDataAlias(oas.GeoIpDataAlias(it.Alias))
+			item.LookupIp = oas.NewOptIpAddress(oas.IpAddress(it.LookupIP.String()))
+		}
+		out = append(out, item)
 	}
 
 	ok := oas.GetIpDataOKApplicationJSON(out)
@@ -6,28 +6,29 @@ import (
 	"github.com/Elessarov1/geocoder-go/internal/server/oas"
 )
 
-// GET /geo/networks?isoCodes=RU&isoCodes=US
+// GET /geo/networks?isoCodes=RU&isoCodes=US&basis=registered
 func (h *GeoCoderHandler) GetCountryNetworks(ctx context.Context, params oas.GetCountryNetworksParams) (oas.GetCountryNetworksRes, error) {
 	isoCodes := make([]string, 0, len(params.IsoCodes))
 	for _, iso := range params.IsoCodes {
 		isoCodes = append(isoCodes, string(iso))
 	}
 
-	items, err := h.api.GetCountryNetworks(ctx, isoCodes)
+	items, err := h.api.GetCountryNetworks(ctx, isoCodes, string(params.Basis.Or(oas.BasisLocated)), params.Provenance.Or(false))
 	if err != nil {
 		return nil, h.toOASError(ctx, err)
 	}
 
 	out := make([]oas.IsoCodeNetworks, 0, len(items))
 	for _, it := range items {
-		networks := make([]oas.Cidr, len(it.Networks))
-		for i, p := range it.Networks {
+		networks := make([]oas.Cidr, it.Networks.Len())
+		for i, p := range it.Networks.All() {
 			networks[i] = oas.Cidr(p.String())
 		}
 
 		out = append(out, oas.IsoCodeNetworks{
-			Code:     oas.IsoCode(it.Code),
-			Networks: networks,
+			Code:       oas.IsoCode(it.Code),
+			Networks:   networks,
+			Provenance: toOASProvenance(it.Provenance),
 		})
 	}
 
@@ -42,18 +43,21 @@ func (h *GeoCoderHandler) GetCountryNetworksPaged(ctx context.Context, params oa
 		string(params.IsoCode),
 		int(params.Page),
 		int(params.Size),
+		string(params.Basis.Or(oas.BasisLocated)),
+		params.Provenance.Or(false),
 	)
 	if err != nil {
 		return nil, h.toOASError(ctx, err)
 	}
 
-	content := make([]oas.Cidr, len(pageData.Content))
-	for i, p := range pageData.Content {
+	content := make([]oas.Cidr, pageData.Content.Len())
+	for i, p := range pageData.Content.All() {
 		content[i] = oas.Cidr(p.String())
 	}
 
 	resp := oas.PageDataString{
 		Content:       content,
+		Provenance:    toOASProvenance(pageData.Provenance),
 		TotalElements: int64(pageData.TotalElements),
 		TotalPages:    int64(pageData.TotalPages),
 		Size:          int64(pageData.Size),
@@ -62,3 +66,15 @@ func (h *GeoCoderHandler) GetCountryNetworksPaged(ctx context.Context, params oa
 
 	return &resp, nil
 }
+
+// toOASProvenance keeps nil as nil, so the field is omitted unless requested.
+func toOASProvenance(in []string) []oas.Provenance {
+	if in == nil {
+		return nil
+	}
+	out := make([]oas.Provenance, len(in))
+	for i, p := range in {
+		out[i] = oas.Provenance(p)
+	}
+	return out
+}
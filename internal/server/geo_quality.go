@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	"github.com/Elessarov1/geocoder-go/internal/geocoder_api"
+	"github.com/Elessarov1/geocoder-go/internal/geoip"
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+)
+
+// GET /geo/quality?limit=50&threshold=10
+func (h *GeoCoderHandler) GetQualityReport(ctx context.Context, params oas.GetQualityReportParams) (oas.GetQualityReportRes, error) {
+	rep, err := h.api.GetQualityReport(ctx, params.Limit.Or(0), params.Threshold.Or(0), params.MinNetworks.Or(0))
+	if err != nil {
+		return nil, h.toOASError(ctx, err)
+	}
+
+	out := &oas.QualityReport{
+		RegisteredDiffers:      toOASNetworkIssues(rep.RegisteredDiffers),
+		TotalRegisteredDiffers: int64(rep.TotalRegisteredDiffers),
+		Fallbacks:              toOASNetworkIssues(rep.Fallbacks),
+		TotalFallbacks:         int64(rep.TotalFallbacks),
+		AliasConflicts:         make([]oas.AliasConflict, 0, len(rep.AliasConflicts)),
+		TotalAliasConflicts:    int64(rep.TotalAliasConflicts),
+		HasPrevious:            rep.HasPrevious,
+		Changes:                make([]oas.CountryChange, 0, len(rep.Changes)),
+		TotalChanges:           int64(rep.TotalChanges),
+	}
+	for _, c := range rep.AliasConflicts {
+		out.AliasConflicts = append(out.AliasConflicts, oas.AliasConflict{
+			Network:     oas.Cidr(c.Network.String()),
+			Code:        oas.IsoCode(c.Code),
+			Alias:       oas.AliasConflictAlias(c.Alias),
+			Ipv4Network: oas.Cidr(c.IPv4.String()),
+			Ipv4Code:    oas.IsoCode(c.IPv4Code),
+		})
+	}
+	for _, c := range rep.Changes {
+		out.Changes = append(out.Changes, oas.CountryChange{
+			Code:    oas.IsoCode(c.Code),
+			Before:  toOASCountryCount(c.Before),
+			After:   toOASCountryCount(c.After),
+			Percent: c.Percent,
+		})
+	}
+	return out, nil
+}
+
+func toOASNetworkIssues(in []geocoder_api.NetworkIssue) []oas.NetworkIssue {
+	out := make([]oas.NetworkIssue, 0, len(in))
+	for _, it := range in {
+		item := oas.NetworkIssue{
+			Network:    oas.Cidr(it.Network.String()),
+			Code:       oas.IsoCode(it.Code),
+			Provenance: oas.Provenance(it.Provenance),
+		}
+		if it.RegisteredCode != "" {
+			item.RegisteredCode = oas.NewOptIsoCode(oas.IsoCode(it.RegisteredCode))
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+func toOASCountryCount(c geoip.CountryCount) oas.CountryCount {
+	return oas.CountryCount{
+		Networks:      int64(c.Networks),
+		Ipv4Addresses: int64(c.IPv4Addresses),
+		Ipv6Slash64s:  int64(min(c.IPv6Slash64s, math.MaxInt64)),
+	}
+}
@@ -0,0 +1,108 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+	"github.com/Elessarov1/geocoder-go/internal/server/oas"
+
+	"github.com/go-faster/jx"
+)
+
+// APIKey returns the key secret of a request: the X-API-Key header, or a
+// bearer token in Authorization.
+func APIKey(r *http.Request) string {
+	if k := strings.TrimSpace(r.Header.Get("X-API-Key")); k != "" {
+		return k
+	}
+	scheme, token, ok := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+// ClientSubject returns the subject of the verified client certificate of an
+// mTLS request, as in "CN=billing,O=Example"; "" without one.
+func ClientSubject(r *http.Request) string {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return r.TLS.VerifiedChains[0][0].Subject.String()
+}
+
+// AuthMiddleware authorizes requests against keys. operation names the
+// OpenAPI operation of a request; requests matching none pass through. Keys
+// are found by secret, or else by client certificate subject.
+// Rejected requests get 401, 403 or 429 (with Retry-After); accepted ones
+// carry the key in their context.
+func AuthMiddleware(keys *auth.Keyring, operation func(*http.Request) string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op := operation(r)
+			if op == "" {
+				// Unknown routes are answered 404 by the API router.
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cred := auth.Credentials{Secret: APIKey(r), Subject: ClientSubject(r)}
+			k, err := keys.Authorize(cred, op, time.Now())
+			auth.Observe("http", k, err)
+			if k != nil {
+				setRequestKey(r.Context(), k.ID)
+			}
+
+			if err != nil {
+				writeAuthError(w, err)
+				return
+			}
+			if k != nil {
+				r = r.WithContext(auth.WithKey(r.Context(), k))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeAuthError(w http.ResponseWriter, err error) {
+	status, code := http.StatusUnauthorized, "auth.unauthenticated"
+
+	var (
+		fe *auth.ForbiddenError
+		qe *auth.QuotaExceededError
+	)
+	switch {
+	case errors.As(err, &fe):
+		status, code = http.StatusForbidden, "auth.forbidden"
+	case errors.As(err, &qe):
+		status, code = http.StatusTooManyRequests, "auth.rate_limited"
+		w.Header().Set("Retry-After", strconv.Itoa(qe.RetryAfterSeconds()))
+	default:
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+
+	writeError(w, status, code, err.Error())
+}
+
+// writeError writes the ErrorResponse body the API handlers use.
+func writeError(w http.ResponseWriter, status int, code, desc string) {
+	resp := oas.ErrorResponse{
+		Result: "ERROR",
+		Error: oas.ErrorResponseError{
+			Code:        code,
+			Description: desc,
+		},
+	}
+	e := jx.GetEncoder()
+	defer jx.PutEncoder(e)
+	resp.Encode(e)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(e.Bytes())
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+)
+
+// testKeyring holds a lookup key allowed a single request, and a lookup key
+// known by certificate subject.
+func testKeyring(t *testing.T) *auth.Keyring {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keys.yaml")
+	content := `
+keys:
+  - id: reader
+    hash: ` + auth.Hash("reader-secret") + `
+    scopes: [lookup]
+    rate: 0.001
+    burst: 1
+  - id: billing
+    subject: CN=billing,O=Example
+    scopes: [lookup]
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	kr, err := auth.ReadKeyFile(path)
+	if err != nil {
+		t.Fatalf("ReadKeyFile: %v", err)
+	}
+	return kr
+}
+
+// operationOf names requests by path, as in "/GetIpData".
+func operationOf(r *http.Request) string {
+	return strings.TrimPrefix(r.URL.Path, "/")
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	var gotKey string
+	h := AuthMiddleware(testKeyring(t), operationOf)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = ""
+		if k, ok := auth.KeyFromContext(r.Context()); ok {
+			gotKey = k.ID
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// Requests run in order: the reader key's single token goes to the
+	// accepted lookup, so the one after it is rate limited.
+	tests := []struct {
+		name    string
+		path    string
+		header  map[string]string
+		status  int
+		code    string
+		key     string
+		retry   string
+		authHdr string
+	}{
+		{name: "public without key", path: "/GetHealth", status: http.StatusOK},
+		{name: "unknown route passes to the router", path: "/", status: http.StatusOK},
+		{
+			name:    "no key",
+			path:    "/GetIpData",
+			status:  http.StatusUnauthorized,
+			code:    "auth.unauthenticated",
+			authHdr: "Bearer",
+		},
+		{
+			name:    "unknown secret",
+			path:    "/GetIpData",
+			header:  map[string]string{"X-API-Key": "nope"},
+			status:  http.StatusUnauthorized,
+			code:    "auth.unauthenticated",
+			authHdr: "Bearer",
+		},
+		{
+			name:   "wrong scope",
+			path:   "/GetCountryNetworks",
+			header: map[string]string{"X-API-Key": "reader-secret"},
+			status: http.StatusForbidden,
+			code:   "auth.forbidden",
+		},
+		{
+			name:   "unknown operation needs admin",
+			path:   "/SomethingNew",
+			header: map[string]string{"Authorization": "Bearer reader-secret"},
+			status: http.StatusForbidden,
+			code:   "auth.forbidden",
+		},
+		{
+			name:   "bearer token in scope",
+			path:   "/GetIpData",
+			header: map[string]string{"Authorization": "bearer reader-secret"},
+			status: http.StatusOK,
+			key:    "reader",
+		},
+		{
+			name:   "bucket ran dry",
+			path:   "/GetIpData",
+			header: map[string]string{"X-API-Key": "reader-secret"},
+			status: http.StatusTooManyRequests,
+			code:   "auth.rate_limited",
+			retry:  "1000",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKey = ""
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d (body %s)", rec.Code, tt.status, rec.Body)
+			}
+			if tt.code != "" && !strings.Contains(rec.Body.String(), `"code":"`+tt.code+`"`) {
+				t.Errorf("body = %s, want code %q", rec.Body, tt.code)
+			}
+			if got := rec.Header().Get("WWW-Authenticate"); got != tt.authHdr {
+				t.Errorf("WWW-Authenticate = %q, want %q", got, tt.authHdr)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.retry {
+				t.Errorf("Retry-After = %q, want %q", got, tt.retry)
+			}
+			if gotKey != tt.key {
+				t.Errorf("key in context = %q, want %q", gotKey, tt.key)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareClientSubject(t *testing.T) {
+	verified := func(cn string) *tls.ConnectionState {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn, Organization: []string{"Example"}}}
+		return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	}
+
+	tests := []struct {
+		name   string
+		tls    *tls.ConnectionState
+		secret string
+		status int
+		key    string
+	}{
+		{name: "known subject", tls: verified("billing"), status: http.StatusOK, key: "billing"},
+		{name: "unknown subject", tls: verified("other"), status: http.StatusUnauthorized},
+		{name: "unverified connection", tls: &tls.ConnectionState{}, status: http.StatusUnauthorized},
+		{name: "secret wins over subject", tls: verified("billing"), secret: "nope", status: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKey string
+			h := AuthMiddleware(testKeyring(t), operationOf)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if k, ok := auth.KeyFromContext(r.Context()); ok {
+					gotKey = k.ID
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/GetIpData", nil)
+			req.TLS = tt.tls
+			if tt.secret != "" {
+				req.Header.Set("X-API-Key", tt.secret)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status || gotKey != tt.key {
+				t.Errorf("status %d, key %q; want %d, %q", rec.Code, gotKey, tt.status, tt.key)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Supported client IP headers (lower-case, as used in config).
+const (
+	HeaderForwarded     = "forwarded"
+	HeaderXForwardedFor = "x-forwarded-for"
+	HeaderXRealIP       = "x-real-ip"
+	HeaderTrueClientIP  = "true-client-ip"
+)
+
+type clientIPKey struct{}
+
+var ctxClientIPKey = clientIPKey{}
+
+func WithClientIP(ctx context.Context, ip netip.Addr) context.Context {
+	return context.WithValue(ctx, ctxClientIPKey, ip)
+}
+
+// ClientIPFromContext returns the client IP resolved by ClientIPMiddleware.
+func ClientIPFromContext(ctx context.Context) (netip.Addr, bool) {
+	ip, ok := ctx.Value(ctxClientIPKey).(netip.Addr)
+	return ip, ok && ip.IsValid()
+}
+
+// ClientIPResolver resolves the real client IP of a request.
+//
+// Forwarding headers are only trusted when they were set by a trusted proxy:
+// the socket peer must belong to one of the trusted prefixes, and chained
+// headers (Forwarded, X-Forwarded-For) are walked right to left, skipping
+// trusted hops, until the first untrusted address is found.
+type ClientIPResolver struct {
+	trusted []netip.Prefix
+	headers []string
+}
+
+func NewClientIPResolver(trustedCIDRs, headers []string) (*ClientIPResolver, error) {
+	r := &ClientIPResolver{
+		trusted: make([]netip.Prefix, 0, len(trustedCIDRs)),
+		headers: make([]string, 0, len(headers)),
+	}
+
+	for _, s := range trustedCIDRs {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !strings.Contains(s, "/") {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", s, err)
+			}
+			addr = addr.Unmap()
+			r.trusted = append(r.trusted, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", s, err)
+		}
+		if p.Addr().Is4In6() && p.Bits() >= 96 {
+			p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+		}
+		r.trusted = append(r.trusted, p.Masked())
+	}
+
+	for _, h := range headers {
+		h = strings.ToLower(strings.TrimSpace(h))
+		switch h {
+		case "":
+			continue
+		case HeaderForwarded, HeaderXForwardedFor, HeaderXRealIP, HeaderTrueClientIP:
+			r.headers = append(r.headers, h)
+		default:
+			return nil, fmt.Errorf("unsupported client ip header %q", h)
+		}
+	}
+
+	return r, nil
+}
+
+// Resolve returns the client IP for r. If the request did not come through a
+// trusted proxy (or no usable header is present) the socket peer is returned.
+// Headers are tried in the configured order and the first address found
+// wins, so only headers the proxies set or overwrite are safe to configure.
+func (cr *ClientIPResolver) Resolve(r *http.Request) netip.Addr {
+	peer := remoteAddr(r)
+	if !peer.IsValid() || !cr.isTrusted(peer) {
+		return peer
+	}
+
+	for _, h := range cr.headers {
+		var ip netip.Addr
+		switch h {
+		case HeaderForwarded:
+			ip = cr.walkChain(forwardedFor(r.Header.Values("Forwarded")))
+		case HeaderXForwardedFor:
+			ip = cr.walkChain(splitList(r.Header.Values("X-Forwarded-For")))
+		case HeaderXRealIP:
+			ip = parseIP(r.Header.Get("X-Real-IP"))
+		case HeaderTrueClientIP:
+			ip = parseIP(r.Header.Get("True-Client-IP"))
+		}
+		if ip.IsValid() {
+			return ip
+		}
+	}
+
+	return peer
+}
+
+func (cr *ClientIPResolver) isTrusted(ip netip.Addr) bool {
+	for _, p := range cr.trusted {
+		if p.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkChain walks hops right to left and returns the first untrusted one.
+// If every hop is trusted, the left-most hop is the client. An unparsable hop
+// aborts the walk: anything to its left cannot be attributed to a trusted proxy.
+func (cr *ClientIPResolver) walkChain(hops []string) netip.Addr {
+	var last netip.Addr
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := parseIP(hops[i])
+		if !ip.IsValid() {
+			return netip.Addr{}
+		}
+		if !cr.isTrusted(ip) {
+			return ip
+		}
+		last = ip
+	}
+	return last
+}
+
+// ClientIPMiddleware stores the resolved client IP in the request context.
+func ClientIPMiddleware(cr *ClientIPResolver) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := cr.Resolve(r)
+			next.ServeHTTP(w, r.WithContext(WithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+func remoteAddr(r *http.Request) netip.Addr {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return parseIP(host)
+}
+
+// parseIP accepts bare addresses as well as "ip:port" and "[ipv6]:port"
+// forms. Zones are dropped and IPv4-mapped addresses are unmapped.
+func parseIP(s string) netip.Addr {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return netip.Addr{}
+	}
+
+	if ap, err := netip.ParseAddrPort(s); err == nil {
+		return ap.Addr().WithZone("").Unmap()
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.WithZone("").Unmap()
+}
+
+func splitList(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			out = append(out, strings.TrimSpace(part))
+		}
+	}
+	return out
+}
+
+// forwardedFor extracts "for=" parameters from RFC 7239 Forwarded headers,
+// one entry per forwarded-element, in order.
+func forwardedFor(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, element := range splitList(values) {
+		var node string
+		for _, pair := range strings.Split(element, ";") {
+			k, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(k), "for") {
+				continue
+			}
+			node = strings.Trim(strings.TrimSpace(v), `"`)
+		}
+		// Obfuscated identifiers ("unknown", "_hidden") fail to parse and
+		// therefore stop the chain walk.
+		out = append(out, node)
+	}
+	return out
+}
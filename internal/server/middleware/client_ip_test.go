@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIPResolver(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		peer    string
+		set     map[string]string
+		want    string
+	}{
+		{
+			name: "untrusted peer with forged headers",
+			peer: "203.0.113.7:5555",
+			set: map[string]string{
+				"X-Forwarded-For": "1.2.3.4",
+				"Forwarded":       "for=1.2.3.4",
+				"X-Real-IP":       "1.2.3.4",
+			},
+			want: "203.0.113.7",
+		},
+		{
+			name: "trusted chain walked right to left",
+			peer: "10.0.0.1:443",
+			set:  map[string]string{"X-Forwarded-For": "198.51.100.9, 10.0.0.3, 10.0.0.2"},
+			want: "198.51.100.9",
+		},
+		{
+			name: "mixed chain stops at the first untrusted hop",
+			peer: "10.0.0.1:443",
+			set:  map[string]string{"X-Forwarded-For": "1.2.3.4, 198.51.100.9, 10.0.0.2"},
+			want: "198.51.100.9",
+		},
+		{
+			name: "every hop trusted",
+			peer: "10.0.0.1:443",
+			set:  map[string]string{"X-Forwarded-For": "10.0.0.5, 10.0.0.2"},
+			want: "10.0.0.5",
+		},
+		{
+			name: "unparsable hop aborts the walk",
+			peer: "10.0.0.1:443",
+			set:  map[string]string{"X-Forwarded-For": "198.51.100.9, garbage, 10.0.0.2"},
+			want: "10.0.0.1",
+		},
+		{
+			name: "forged headers not configured are ignored",
+			peer: "10.0.0.1:443",
+			set: map[string]string{
+				"X-Forwarded-For": "198.51.100.9",
+				"X-Real-IP":       "1.2.3.4",
+				"Forwarded":       "for=1.2.3.4",
+			},
+			want: "198.51.100.9",
+		},
+		{
+			name:    "RFC 7239 quoted, bracketed and with port",
+			headers: []string{HeaderForwarded},
+			peer:    "10.0.0.1:443",
+			set:     map[string]string{"Forwarded": `for="[2001:db8::7]:4711";proto=https, for=10.0.0.2`},
+			want:    "2001:db8::7",
+		},
+		{
+			name:    "RFC 7239 obfuscated node stops the walk",
+			headers: []string{HeaderForwarded},
+			peer:    "10.0.0.1:443",
+			set:     map[string]string{"Forwarded": `for=198.51.100.9, for=_hidden`},
+			want:    "10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := tt.headers
+			if headers == nil {
+				headers = []string{HeaderXForwardedFor}
+			}
+			cr, err := NewClientIPResolver([]string{"10.0.0.0/24"}, headers)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.peer
+			for k, v := range tt.set {
+				r.Header.Set(k, v)
+			}
+			if got := cr.Resolve(r); got.String() != tt.want {
+				t.Errorf("Resolve = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClientIPResolverRejectsUnknownInput(t *testing.T) {
+	if _, err := NewClientIPResolver([]string{"10.0.0.0/33"}, nil); err == nil {
+		t.Error("invalid trusted prefix accepted")
+	}
+	if _, err := NewClientIPResolver(nil, []string{"x-client-ip"}); err == nil {
+		t.Error("unsupported header accepted")
+	}
+}
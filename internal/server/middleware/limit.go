@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Elessarov1/geocoder-go/internal/auth"
+	"github.com/Elessarov1/geocoder-go/internal/ratelimit"
+)
+
+// LimitMiddleware applies l to requests for heavy operations, as named by
+// operation. Clients are told apart by API key, or else by client IP.
+// Rejected requests get 429 with Retry-After.
+func LimitMiddleware(l *ratelimit.Limiter, operation func(*http.Request) string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !ratelimit.Heavy(operation(r)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			release, err := l.Acquire(clientID(r), time.Now())
+			if err != nil {
+				ratelimit.Observe("http", err)
+				var le *ratelimit.LimitError
+				if errors.As(err, &le) {
+					w.Header().Set("Retry-After", strconv.Itoa(le.RetryAfterSeconds()))
+				}
+				writeError(w, http.StatusTooManyRequests, "limit.exceeded", err.Error())
+				return
+			}
+			defer release()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientID(r *http.Request) string {
+	if k, ok := auth.KeyFromContext(r.Context()); ok {
+		return "key " + k.ID
+	}
+	return getIP(r)
+}
@@ -1,10 +1,10 @@
 package middleware
 
 import (
+	"context"
 	"github.com/Elessarov1/geocoder-go/internal/common/logger"
 	"net"
 	"net/http"
-	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -34,12 +34,29 @@ func (rw *responseWriter) Write(b []byte) (int, error) {
 	return n, err
 }
 
+// requestLog collects what inner middlewares learn about a request for its
+// log line.
+type requestLog struct {
+	apiKey string
+}
+
+type requestLogKey struct{}
+
+// setRequestKey records the API key ID of the request being logged.
+func setRequestKey(ctx context.Context, id string) {
+	if rl, ok := ctx.Value(requestLogKey{}).(*requestLog); ok {
+		rl.apiKey = id
+	}
+}
+
 func LoggerMiddleware(lg *zap.Logger, cors bool) Middleware {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 			ctx := r.Context()
 			ctx = logger.WithLogger(ctx, lg)
+			rl := &requestLog{}
+			ctx = context.WithValue(ctx, requestLogKey{}, rl)
 
 			start := time.Now()
 			ip := getIP(r)
@@ -59,38 +76,19 @@ func LoggerMiddleware(lg *zap.Logger, cors bool) Middleware {
 				zap.Int("response_size", rw.size),
 				zap.Duration("duration", time.Since(start)),
 				zap.String("client_ip", ip),
+				zap.String("api_key", rl.apiKey),
+				zap.String("client_subject", ClientSubject(r)),
 			)
 		})
 	}
 }
 
 func getIP(r *http.Request) string {
-	var ip string
-
-	// X-Forwarded-For
-	ip = r.Header.Get("X-Forwarded-For")
-	ci := strings.Index(ip, ",")
-	if ci == -1 {
-		ci = len(ip)
-	}
-	ip = strings.TrimSpace(ip[:ci])
-	if ip != "" && net.ParseIP(ip) != nil {
-		return ip
-	}
-
-	// X-Real-IP
-	ip = r.Header.Get("X-Real-IP")
-	if ip != "" && net.ParseIP(ip) != nil {
-		return ip
-	}
-
-	// True-Client-IP
-	ip = r.Header.Get("True-Client-IP")
-	if ip != "" && net.ParseIP(ip) != nil {
-		return ip
+	if ip, ok := ClientIPFromContext(r.Context()); ok {
+		return ip.String()
 	}
 
 	// Socket
-	ip, _, _ = net.SplitHostPort(r.RemoteAddr)
+	ip, _, _ := net.SplitHostPort(r.RemoteAddr)
 	return ip
 }
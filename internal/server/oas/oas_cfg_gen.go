@@ -11,7 +11,12 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
-	"^[A-Z]{2}$": ogenregex.MustCompile("^[A-Z]{2}$"),
+	"^[0-9]+$":                      ogenregex.MustCompile("^[0-9]+$"),
+	"^[0-9]{3}$":                    ogenregex.MustCompile("^[0-9]{3}$"),
+	"^[A-Z]{2}$":                    ogenregex.MustCompile("^[A-Z]{2}$"),
+	"^[A-Za-z][A-Za-z0-9_]+$":       ogenregex.MustCompile("^[A-Za-z][A-Za-z0-9_]+$"),
+	"^[A-Za-z]{2,3}$":               ogenregex.MustCompile("^[A-Za-z]{2,3}$"),
+	"^[A-Za-z_][A-Za-z0-9_]{0,27}$": ogenregex.MustCompile("^[A-Za-z_][A-Za-z0-9_]{0,27}$"),
 }
 
 type (
@@ -0,0 +1,27 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package oas
+
+// setDefaults set default value of fields.
+func (s *CidrPayload) setDefaults() {
+	{
+		val := int32(0)
+		s.Page.SetTo(val)
+	}
+	{
+		val := int32(1000)
+		s.Size.SetTo(val)
+	}
+}
+
+// setDefaults set default value of fields.
+func (s *GeoPayload) setDefaults() {
+	{
+		val := bool(false)
+		s.Provenance.SetTo(val)
+	}
+	{
+		val := Basis("located")
+		s.Basis.SetTo(val)
+	}
+}
@@ -28,39 +28,73 @@ func (c *codeRecorder) Unwrap() http.ResponseWriter {
 
 func recordError(string, error) {}
 
-// handleGetCountriesRequest handles getCountries operation.
+// handleEvaluateExpressionRequest handles evaluateExpression operation.
 //
-// Получение полного перечня кодов стран.
+// Выражение строится из ISO кодов стран, групп (см. /geo/groups)
+// и CIDR:
+// "+" или "|" объединение, "&" пересечение, "-" разность,
+// скобки для группировки.
+// "&" выполняется раньше "+" и "-". Пример: "EU + CH - (185.0.0.0/16 +
+// 2001:db8::/32)".
+// Результат возвращается минимальным списком CIDR в JSON
+// или в формате экспорта.
 //
-// GET /geo/countries
-func (s *Server) handleGetCountriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /geo/expression
+func (s *Server) handleEvaluateExpressionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
 
 	var (
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EvaluateExpressionOperation,
+			ID:   "evaluateExpression",
+		}
 	)
+	params, err := decodeEvaluateExpressionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response GetCountriesRes
+	var response EvaluateExpressionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCountriesOperation,
-			OperationSummary: "Получение полного перечня кодов стран",
-			OperationID:      "getCountries",
+			OperationName:    EvaluateExpressionOperation,
+			OperationSummary: "Вычисление выражения над списками подсетей стран",
+			OperationID:      "evaluateExpression",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "expression",
+					In:   "query",
+				}: params.Expression,
+				{
+					Name: "format",
+					In:   "query",
+				}: params.Format,
+				{
+					Name: "name",
+					In:   "query",
+				}: params.Name,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetCountriesRes
+			Params   = EvaluateExpressionParams
+			Response = EvaluateExpressionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -69,14 +103,14 @@ func (s *Server) handleGetCountriesRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			nil,
+			unpackEvaluateExpressionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCountries(ctx)
+				response, err = s.h.EvaluateExpression(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCountries(ctx)
+		response, err = s.h.EvaluateExpression(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
@@ -95,7 +129,7 @@ func (s *Server) handleGetCountriesRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeGetCountriesResponse(response, w); err != nil {
+	if err := encodeEvaluateExpressionResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -104,12 +138,12 @@ func (s *Server) handleGetCountriesRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleGetCountryNetworksRequest handles getCountryNetworks operation.
+// handleExplainIpRequest handles explainIp operation.
 //
-// Получение полного перечня подсетей по коду страны.
+// Подробный разбор определения страны по ip адресу.
 //
-// GET /geo/networks
-func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /geo/explain
+func (s *Server) handleExplainIpRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -117,11 +151,11 @@ func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped boo
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCountryNetworksOperation,
-			ID:   "getCountryNetworks",
+			Name: ExplainIpOperation,
+			ID:   "explainIp",
 		}
 	)
-	params, err := decodeGetCountryNetworksParams(args, argsEscaped, r)
+	params, err := decodeExplainIpParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -134,28 +168,28 @@ func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped boo
 
 	var rawBody []byte
 
-	var response GetCountryNetworksRes
+	var response ExplainIpRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCountryNetworksOperation,
-			OperationSummary: "Получение полного перечня подсетей по коду страны",
-			OperationID:      "getCountryNetworks",
+			OperationName:    ExplainIpOperation,
+			OperationSummary: "Подробный разбор определения страны по ip адресу",
+			OperationID:      "explainIp",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "isoCodes",
+					Name: "ip",
 					In:   "query",
-				}: params.IsoCodes,
+				}: params.IP,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCountryNetworksParams
-			Response = GetCountryNetworksRes
+			Params   = ExplainIpParams
+			Response = ExplainIpRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -164,14 +198,14 @@ func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetCountryNetworksParams,
+			unpackExplainIpParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCountryNetworks(ctx, params)
+				response, err = s.h.ExplainIp(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCountryNetworks(ctx, params)
+		response, err = s.h.ExplainIp(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
@@ -190,7 +224,7 @@ func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetCountryNetworksResponse(response, w); err != nil {
+	if err := encodeExplainIpResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -199,13 +233,13 @@ func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleGetCountryNetworksPagedRequest handles getCountryNetworksPaged operation.
+// handleGetBogonsRequest handles getBogons operation.
 //
-// Получение перечня подсетей по коду страны
-// постранично.
+// Список bogon-сетей (не глобально достижимых по реестрам
+// IANA) для фильтрации.
 //
-// GET /geo/networks/paged
-func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /geo/bogons
+func (s *Server) handleGetBogonsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -213,11 +247,11 @@ func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscape
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetCountryNetworksPagedOperation,
-			ID:   "getCountryNetworksPaged",
+			Name: GetBogonsOperation,
+			ID:   "getBogons",
 		}
 	)
-	params, err := decodeGetCountryNetworksPagedParams(args, argsEscaped, r)
+	params, err := decodeGetBogonsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -230,36 +264,36 @@ func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscape
 
 	var rawBody []byte
 
-	var response GetCountryNetworksPagedRes
+	var response GetBogonsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetCountryNetworksPagedOperation,
-			OperationSummary: "Получение перечня подсетей по коду страны постранично",
-			OperationID:      "getCountryNetworksPaged",
+			OperationName:    GetBogonsOperation,
+			OperationSummary: "Список bogon-сетей (не глобально достижимых по реестрам IANA) для фильтрации",
+			OperationID:      "getBogons",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "isoCode",
+					Name: "family",
 					In:   "query",
-				}: params.IsoCode,
+				}: params.Family,
 				{
-					Name: "page",
+					Name: "format",
 					In:   "query",
-				}: params.Page,
+				}: params.Format,
 				{
-					Name: "size",
+					Name: "name",
 					In:   "query",
-				}: params.Size,
+				}: params.Name,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetCountryNetworksPagedParams
-			Response = GetCountryNetworksPagedRes
+			Params   = GetBogonsParams
+			Response = GetBogonsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -268,14 +302,14 @@ func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetCountryNetworksPagedParams,
+			unpackGetBogonsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetCountryNetworksPaged(ctx, params)
+				response, err = s.h.GetBogons(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetCountryNetworksPaged(ctx, params)
+		response, err = s.h.GetBogons(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
@@ -294,7 +328,7 @@ func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeGetCountryNetworksPagedResponse(response, w); err != nil {
+	if err := encodeGetBogonsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -303,12 +337,111 @@ func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscape
 	}
 }
 
-// handleGetHealthRequest handles getHealth operation.
+// handleGetCidrDataRequest handles getCidrData operation.
 //
-// Get service health.
+// Для каждого CIDR возвращает точное совпадение (если
+// такая подсеть есть в наборе),
+// все подсети набора, которые его содержат, и
+// постранично все подсети, которые
+// содержатся в нём, с кодами стран.
 //
-// GET /v1/health
-func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /geo/cidr_data
+func (s *Server) handleGetCidrDataRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCidrDataOperation,
+			ID:   "getCidrData",
+		}
+	)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetCidrDataRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GetCidrDataRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCidrDataOperation,
+			OperationSummary: "Поиск подсетей набора данных по перечню CIDR",
+			OperationID:      "getCidrData",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *CidrPayload
+			Params   = struct{}
+			Response = GetCidrDataRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCidrData(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCidrData(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetCidrDataResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCountriesRequest handles getCountries operation.
+//
+// Получение полного перечня кодов стран.
+//
+// GET /geo/countries
+func (s *Server) handleGetCountriesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -319,13 +452,13 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 
 	var rawBody []byte
 
-	var response *Health
+	var response GetCountriesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetHealthOperation,
-			OperationSummary: "Get service health",
-			OperationID:      "getHealth",
+			OperationName:    GetCountriesOperation,
+			OperationSummary: "Получение полного перечня кодов стран",
+			OperationID:      "getCountries",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
@@ -335,7 +468,7 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = *Health
+			Response = GetCountriesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -346,12 +479,12 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetHealth(ctx)
+				response, err = s.h.GetCountries(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetHealth(ctx)
+		response, err = s.h.GetCountries(ctx)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
@@ -370,7 +503,7 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetHealthResponse(response, w); err != nil {
+	if err := encodeGetCountriesResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -379,12 +512,13 @@ func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleGetIpDataRequest handles getIpData operation.
+// handleGetCountryRequest handles getCountry operation.
 //
-// Получение кодов стран по перечню ip адресов.
+// Справочные данные ISO 3166 по стране и её доля в наборе
+// данных.
 //
-// POST /geo/ip_data
-func (s *Server) handleGetIpDataRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /geo/countries/{code}
+func (s *Server) handleGetCountryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -392,45 +526,45 @@ func (s *Server) handleGetIpDataRequest(args [0]string, argsEscaped bool, w http
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetIpDataOperation,
-			ID:   "getIpData",
+			Name: GetCountryOperation,
+			ID:   "getCountry",
 		}
 	)
-
-	var rawBody []byte
-	request, rawBody, close, err := s.decodeGetIpDataRequest(r)
+	params, err := decodeGetCountryParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response GetIpDataRes
+	var rawBody []byte
+
+	var response GetCountryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetIpDataOperation,
-			OperationSummary: "Получение кодов стран по перечню ip адресов",
-			OperationID:      "getIpData",
-			Body:             request,
+			OperationName:    GetCountryOperation,
+			OperationSummary: "Справочные данные ISO 3166 по стране и её доля в наборе данных",
+			OperationID:      "getCountry",
+			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *GeoPayload
-			Params   = struct{}
-			Response = GetIpDataRes
+			Request  = struct{}
+			Params   = GetCountryParams
+			Response = GetCountryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -439,14 +573,14 @@ func (s *Server) handleGetIpDataRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackGetCountryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetIpData(ctx, request)
+				response, err = s.h.GetCountry(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetIpData(ctx, request)
+		response, err = s.h.GetCountry(ctx, params)
 	}
 	if err != nil {
 		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
@@ -465,7 +599,757 @@ func (s *Server) handleGetIpDataRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeGetIpDataResponse(response, w); err != nil {
+	if err := encodeGetCountryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCountryNetworksRequest handles getCountryNetworks operation.
+//
+// Получение полного перечня подсетей по коду страны.
+//
+// GET /geo/networks
+func (s *Server) handleGetCountryNetworksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCountryNetworksOperation,
+			ID:   "getCountryNetworks",
+		}
+	)
+	params, err := decodeGetCountryNetworksParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetCountryNetworksRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCountryNetworksOperation,
+			OperationSummary: "Получение полного перечня подсетей по коду страны",
+			OperationID:      "getCountryNetworks",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "isoCodes",
+					In:   "query",
+				}: params.IsoCodes,
+				{
+					Name: "provenance",
+					In:   "query",
+				}: params.Provenance,
+				{
+					Name: "basis",
+					In:   "query",
+				}: params.Basis,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCountryNetworksParams
+			Response = GetCountryNetworksRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCountryNetworksParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCountryNetworks(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCountryNetworks(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetCountryNetworksResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCountryNetworksPagedRequest handles getCountryNetworksPaged operation.
+//
+// Получение перечня подсетей по коду страны
+// постранично.
+//
+// GET /geo/networks/paged
+func (s *Server) handleGetCountryNetworksPagedRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCountryNetworksPagedOperation,
+			ID:   "getCountryNetworksPaged",
+		}
+	)
+	params, err := decodeGetCountryNetworksPagedParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetCountryNetworksPagedRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCountryNetworksPagedOperation,
+			OperationSummary: "Получение перечня подсетей по коду страны постранично",
+			OperationID:      "getCountryNetworksPaged",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "isoCode",
+					In:   "query",
+				}: params.IsoCode,
+				{
+					Name: "page",
+					In:   "query",
+				}: params.Page,
+				{
+					Name: "size",
+					In:   "query",
+				}: params.Size,
+				{
+					Name: "provenance",
+					In:   "query",
+				}: params.Provenance,
+				{
+					Name: "basis",
+					In:   "query",
+				}: params.Basis,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetCountryNetworksPagedParams
+			Response = GetCountryNetworksPagedRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetCountryNetworksPagedParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCountryNetworksPaged(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCountryNetworksPaged(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetCountryNetworksPagedResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDatasetRequest handles getDataset operation.
+//
+// Метаданные загруженного набора данных и его
+// источников.
+//
+// GET /geo/dataset
+func (s *Server) handleGetDatasetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var rawBody []byte
+
+	var response GetDatasetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDatasetOperation,
+			OperationSummary: "Метаданные загруженного набора данных и его источников",
+			OperationID:      "getDataset",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetDatasetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDataset(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDataset(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetDatasetResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetGroupsRequest handles getGroups operation.
+//
+// Каталог групп стран (континенты, союзы,
+// пользовательские группы).
+//
+// GET /geo/groups
+func (s *Server) handleGetGroupsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var rawBody []byte
+
+	var response GetGroupsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetGroupsOperation,
+			OperationSummary: "Каталог групп стран (континенты, союзы, пользовательские группы)",
+			OperationID:      "getGroups",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetGroupsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetGroups(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetGroups(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetGroupsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetHealthRequest handles getHealth operation.
+//
+// Get service health.
+//
+// GET /v1/health
+func (s *Server) handleGetHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var rawBody []byte
+
+	var response *Health
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetHealthOperation,
+			OperationSummary: "Get service health",
+			OperationID:      "getHealth",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *Health
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetHealth(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetHealth(ctx)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetHealthResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetIpDataRequest handles getIpData operation.
+//
+// Получение кодов стран по перечню ip адресов.
+//
+// POST /geo/ip_data
+func (s *Server) handleGetIpDataRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetIpDataOperation,
+			ID:   "getIpData",
+		}
+	)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetIpDataRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GetIpDataRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetIpDataOperation,
+			OperationSummary: "Получение кодов стран по перечню ip адресов",
+			OperationID:      "getIpData",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *GeoPayload
+			Params   = struct{}
+			Response = GetIpDataRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetIpData(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetIpData(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetIpDataResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetQualityReportRequest handles getQualityReport operation.
+//
+// Подсети, страна которых отличается от registered_country;
+// подсети с кодом по умолчанию (ZZ);
+// IPv6 подсети алиасов IPv4 (::ffff:0:0/96, 2002::/16, 2001::/32), код
+// которых расходится с IPv4 подсетью
+// (встречаются, только если алиасы не пропускаются при
+// загрузке); страны, число подсетей или адресов
+// которых резко изменилось относительно предыдущей
+// сборки снапшота.
+//
+// GET /geo/quality
+func (s *Server) handleGetQualityReportRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetQualityReportOperation,
+			ID:   "getQualityReport",
+		}
+	)
+	params, err := decodeGetQualityReportParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetQualityReportRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetQualityReportOperation,
+			OperationSummary: "Отчёт о качестве загруженного набора данных",
+			OperationID:      "getQualityReport",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "threshold",
+					In:   "query",
+				}: params.Threshold,
+				{
+					Name: "minNetworks",
+					In:   "query",
+				}: params.MinNetworks,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetQualityReportParams
+			Response = GetQualityReportRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetQualityReportParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetQualityReport(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetQualityReport(ctx, params)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetQualityReportResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRangeBreakdownRequest handles getRangeBreakdown operation.
+//
+// Принимает CIDR, диапазоны вида "185.0.0.0-185.3.255.255" и
+// отдельные адреса.
+// Для каждого диапазона возвращает страны с
+// пересекающимися подсетями набора,
+// точным количеством адресов и долей диапазона, а также
+// непокрытые набором участки.
+//
+// POST /geo/breakdown
+func (s *Server) handleGetRangeBreakdownRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRangeBreakdownOperation,
+			ID:   "getRangeBreakdown",
+		}
+	)
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeGetRangeBreakdownRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response GetRangeBreakdownRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRangeBreakdownOperation,
+			OperationSummary: "Разбивка диапазонов адресов по странам",
+			OperationID:      "getRangeBreakdown",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *BreakdownPayload
+			Params   = struct{}
+			Response = GetRangeBreakdownRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRangeBreakdown(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRangeBreakdown(ctx, request)
+	}
+	if err != nil {
+		if errRes, ok := errors.Into[*DefaultErrorStatusCode](err); ok {
+			if err := encodeErrorResponse(errRes, w); err != nil {
+				defer recordError("Internal", err)
+			}
+			return
+		}
+		if errors.Is(err, ht.ErrNotImplemented) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+		if err := encodeErrorResponse(s.h.NewError(ctx, err), w); err != nil {
+			defer recordError("Internal", err)
+		}
+		return
+	}
+
+	if err := encodeGetRangeBreakdownResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
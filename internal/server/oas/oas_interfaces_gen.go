@@ -1,6 +1,22 @@
 // Code generated by ogen, DO NOT EDIT.
 package oas
 
+type EvaluateExpressionRes interface {
+	evaluateExpressionRes()
+}
+
+type ExplainIpRes interface {
+	explainIpRes()
+}
+
+type GetBogonsRes interface {
+	getBogonsRes()
+}
+
+type GetCidrDataRes interface {
+	getCidrDataRes()
+}
+
 type GetCountriesRes interface {
 	getCountriesRes()
 }
@@ -13,6 +29,26 @@ type GetCountryNetworksRes interface {
 	getCountryNetworksRes()
 }
 
+type GetCountryRes interface {
+	getCountryRes()
+}
+
+type GetDatasetRes interface {
+	getDatasetRes()
+}
+
+type GetGroupsRes interface {
+	getGroupsRes()
+}
+
 type GetIpDataRes interface {
 	getIpDataRes()
 }
+
+type GetQualityReportRes interface {
+	getQualityReportRes()
+}
+
+type GetRangeBreakdownRes interface {
+	getRangeBreakdownRes()
+}
@@ -6,9 +6,18 @@ package oas
 type OperationName = string
 
 const (
+	EvaluateExpressionOperation      OperationName = "EvaluateExpression"
+	ExplainIpOperation               OperationName = "ExplainIp"
+	GetBogonsOperation               OperationName = "GetBogons"
+	GetCidrDataOperation             OperationName = "GetCidrData"
 	GetCountriesOperation            OperationName = "GetCountries"
+	GetCountryOperation              OperationName = "GetCountry"
 	GetCountryNetworksOperation      OperationName = "GetCountryNetworks"
 	GetCountryNetworksPagedOperation OperationName = "GetCountryNetworksPaged"
+	GetDatasetOperation              OperationName = "GetDataset"
+	GetGroupsOperation               OperationName = "GetGroups"
 	GetHealthOperation               OperationName = "GetHealth"
 	GetIpDataOperation               OperationName = "GetIpData"
+	GetQualityReportOperation        OperationName = "GetQualityReport"
+	GetRangeBreakdownOperation       OperationName = "GetRangeBreakdown"
 )
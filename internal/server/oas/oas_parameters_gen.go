@@ -5,6 +5,7 @@ package oas
 import (
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/go-faster/errors"
 	"github.com/ogen-go/ogen/conv"
@@ -14,10 +15,601 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// EvaluateExpressionParams is parameters of evaluateExpression operation.
+type EvaluateExpressionParams struct {
+	Expression string
+	Format     OptExportFormat `json:",omitempty,omitzero"`
+	// Имя переменной nginx, ipset или define nftables (по умолчанию geocoder).
+	Name OptString `json:",omitempty,omitzero"`
+}
+
+func unpackEvaluateExpressionParams(packed middleware.Parameters) (params EvaluateExpressionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "expression",
+			In:   "query",
+		}
+		params.Expression = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptExportFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeEvaluateExpressionParams(args [0]string, argsEscaped bool, r *http.Request) (params EvaluateExpressionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: expression.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "expression",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Expression = c
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := (validate.String{
+					MinLength:     1,
+					MinLengthSet:  true,
+					MaxLength:     4096,
+					MaxLengthSet:  true,
+					Email:         false,
+					Hostname:      false,
+					Regex:         nil,
+					MinNumeric:    0,
+					MinNumericSet: false,
+					MaxNumeric:    0,
+					MaxNumericSet: false,
+				}).Validate(string(params.Expression)); err != nil {
+					return errors.Wrap(err, "string")
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "expression",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: format.
+	{
+		val := ExportFormat("json")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal ExportFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = ExportFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Name.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         regexMap["^[A-Za-z_][A-Za-z0-9_]{0,27}$"],
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// ExplainIpParams is parameters of explainIp operation.
+type ExplainIpParams struct {
+	IP IpAddress
+}
+
+func unpackExplainIpParams(packed middleware.Parameters) (params ExplainIpParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "ip",
+			In:   "query",
+		}
+		params.IP = packed[key].(IpAddress)
+	}
+	return params
+}
+
+func decodeExplainIpParams(args [0]string, argsEscaped bool, r *http.Request) (params ExplainIpParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: ip.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "ip",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIPVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIPVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IP = IpAddress(paramsDotIPVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "ip",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetBogonsParams is parameters of getBogons operation.
+type GetBogonsParams struct {
+	Family AddressFamily
+	Format OptExportFormat `json:",omitempty,omitzero"`
+	// Имя переменной nginx, ipset или define nftables (по умолчанию geocoder).
+	Name OptString `json:",omitempty,omitzero"`
+}
+
+func unpackGetBogonsParams(packed middleware.Parameters) (params GetBogonsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "family",
+			In:   "query",
+		}
+		params.Family = packed[key].(AddressFamily)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "format",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Format = v.(OptExportFormat)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "name",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Name = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeGetBogonsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetBogonsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: family.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "family",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Family = AddressFamily(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Family.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "family",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: format.
+	{
+		val := ExportFormat("json")
+		params.Format.SetTo(val)
+	}
+	// Decode query: format.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "format",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotFormatVal ExportFormat
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotFormatVal = ExportFormat(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Format.SetTo(paramsDotFormatVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Format.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "format",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: name.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "name",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotNameVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotNameVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Name.SetTo(paramsDotNameVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Name.Get(); ok {
+					if err := func() error {
+						if err := (validate.String{
+							MinLength:     0,
+							MinLengthSet:  false,
+							MaxLength:     0,
+							MaxLengthSet:  false,
+							Email:         false,
+							Hostname:      false,
+							Regex:         regexMap["^[A-Za-z_][A-Za-z0-9_]{0,27}$"],
+							MinNumeric:    0,
+							MinNumericSet: false,
+							MaxNumeric:    0,
+							MaxNumericSet: false,
+						}).Validate(string(value)); err != nil {
+							return errors.Wrap(err, "string")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "name",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetCountryParams is parameters of getCountry operation.
+type GetCountryParams struct {
+	Code CountryCode
+}
+
+func unpackGetCountryParams(packed middleware.Parameters) (params GetCountryParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(CountryCode)
+	}
+	return params
+}
+
+func decodeGetCountryParams(args [1]string, argsEscaped bool, r *http.Request) (params GetCountryParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				var paramsDotCodeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotCodeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Code = CountryCode(paramsDotCodeVal)
+				return nil
+			}(); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Code.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetCountryNetworksParams is parameters of getCountryNetworks operation.
 type GetCountryNetworksParams struct {
-	// Список ISO2 кодов стран (уникальные).
-	IsoCodes []IsoCode `json:",omitempty"`
+	// Список ISO2 кодов стран и идентификаторов групп
+	// (уникальные); группа раскрывается в страны-участницы.
+	IsoCodes []CountryOrGroup `json:",omitempty"`
+	// Вернуть слой набора данных, из которого получена
+	// каждая подсеть.
+	Provenance OptBool  `json:",omitempty,omitzero"`
+	Basis      OptBasis `json:",omitempty,omitzero"`
 }
 
 func unpackGetCountryNetworksParams(packed middleware.Parameters) (params GetCountryNetworksParams) {
@@ -26,7 +618,25 @@ func unpackGetCountryNetworksParams(packed middleware.Parameters) (params GetCou
 			Name: "isoCodes",
 			In:   "query",
 		}
-		params.IsoCodes = packed[key].([]IsoCode)
+		params.IsoCodes = packed[key].([]CountryOrGroup)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "provenance",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Provenance = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "basis",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Basis = v.(OptBasis)
+		}
 	}
 	return params
 }
@@ -44,7 +654,7 @@ func decodeGetCountryNetworksParams(args [0]string, argsEscaped bool, r *http.Re
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
 				return d.DecodeArray(func(d uri.Decoder) error {
-					var paramsDotIsoCodesVal IsoCode
+					var paramsDotIsoCodesVal CountryOrGroup
 					if err := func() error {
 						var paramsDotIsoCodesValVal string
 						if err := func() error {
@@ -63,7 +673,7 @@ func decodeGetCountryNetworksParams(args [0]string, argsEscaped bool, r *http.Re
 						}(); err != nil {
 							return err
 						}
-						paramsDotIsoCodesVal = IsoCode(paramsDotIsoCodesValVal)
+						paramsDotIsoCodesVal = CountryOrGroup(paramsDotIsoCodesValVal)
 						return nil
 					}(); err != nil {
 						return err
@@ -121,16 +731,129 @@ func decodeGetCountryNetworksParams(args [0]string, argsEscaped bool, r *http.Re
 			Err:  err,
 		}
 	}
+	// Set default value for query: provenance.
+	{
+		val := bool(false)
+		params.Provenance.SetTo(val)
+	}
+	// Decode query: provenance.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "provenance",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProvenanceVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProvenanceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Provenance.SetTo(paramsDotProvenanceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provenance",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: basis.
+	{
+		val := Basis("located")
+		params.Basis.SetTo(val)
+	}
+	// Decode query: basis.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "basis",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBasisVal Basis
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBasisVal = Basis(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Basis.SetTo(paramsDotBasisVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Basis.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "basis",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
 
 // GetCountryNetworksPagedParams is parameters of getCountryNetworksPaged operation.
 type GetCountryNetworksPagedParams struct {
-	IsoCode IsoCode
+	// ISO2/ISO3 код страны или идентификатор группы (подсети
+	// участников идут подряд).
+	IsoCode CountryOrGroup
 	// Номер страницы (0..).
 	Page int32
 	// Размер страницы.
 	Size int32
+	// Вернуть слой набора данных, из которого получена
+	// каждая подсеть.
+	Provenance OptBool  `json:",omitempty,omitzero"`
+	Basis      OptBasis `json:",omitempty,omitzero"`
 }
 
 func unpackGetCountryNetworksPagedParams(packed middleware.Parameters) (params GetCountryNetworksPagedParams) {
@@ -139,21 +862,39 @@ func unpackGetCountryNetworksPagedParams(packed middleware.Parameters) (params G
 			Name: "isoCode",
 			In:   "query",
 		}
-		params.IsoCode = packed[key].(IsoCode)
+		params.IsoCode = packed[key].(CountryOrGroup)
 	}
 	{
 		key := middleware.ParameterKey{
 			Name: "page",
 			In:   "query",
 		}
-		params.Page = packed[key].(int32)
+		params.Page = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "size",
+			In:   "query",
+		}
+		params.Size = packed[key].(int32)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "provenance",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Provenance = v.(OptBool)
+		}
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "size",
+			Name: "basis",
 			In:   "query",
 		}
-		params.Size = packed[key].(int32)
+		if v, ok := packed[key]; ok {
+			params.Basis = v.(OptBasis)
+		}
 	}
 	return params
 }
@@ -187,7 +928,7 @@ func decodeGetCountryNetworksPagedParams(args [0]string, argsEscaped bool, r *ht
 				}(); err != nil {
 					return err
 				}
-				params.IsoCode = IsoCode(paramsDotIsoCodeVal)
+				params.IsoCode = CountryOrGroup(paramsDotIsoCodeVal)
 				return nil
 			}); err != nil {
 				return err
@@ -319,5 +1060,357 @@ func decodeGetCountryNetworksPagedParams(args [0]string, argsEscaped bool, r *ht
 			Err:  err,
 		}
 	}
+	// Set default value for query: provenance.
+	{
+		val := bool(false)
+		params.Provenance.SetTo(val)
+	}
+	// Decode query: provenance.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "provenance",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotProvenanceVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotProvenanceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Provenance.SetTo(paramsDotProvenanceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "provenance",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: basis.
+	{
+		val := Basis("located")
+		params.Basis.SetTo(val)
+	}
+	// Decode query: basis.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "basis",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBasisVal Basis
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBasisVal = Basis(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Basis.SetTo(paramsDotBasisVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Basis.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "basis",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetQualityReportParams is parameters of getQualityReport operation.
+type GetQualityReportParams struct {
+	// Максимум записей в каждом списке (по умолчанию 100).
+	Limit OptInt `json:",omitempty,omitzero"`
+	// Порог изменения страны в процентах (по умолчанию 20).
+	Threshold OptFloat64 `json:",omitempty,omitzero"`
+	// Страны, у которых меньше подсетей в обеих сборках, не
+	// сравниваются (по умолчанию 10).
+	MinNetworks OptInt `json:",omitempty,omitzero"`
+}
+
+func unpackGetQualityReportParams(packed middleware.Parameters) (params GetQualityReportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "threshold",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Threshold = v.(OptFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "minNetworks",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.MinNetworks = v.(OptInt)
+		}
+	}
+	return params
+}
+
+func decodeGetQualityReportParams(args [0]string, argsEscaped bool, r *http.Request) (params GetQualityReportParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Limit.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           1,
+							MaxSet:        true,
+							Max:           100000,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: threshold.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "threshold",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotThresholdVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotThresholdVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Threshold.SetTo(paramsDotThresholdVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Threshold.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    nil,
+							Pattern:       nil,
+						}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "threshold",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: minNetworks.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "minNetworks",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotMinNetworksVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotMinNetworksVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.MinNetworks.SetTo(paramsDotMinNetworksVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.MinNetworks.Get(); ok {
+					if err := func() error {
+						if err := (validate.Int{
+							MinSet:        true,
+							Min:           0,
+							MaxSet:        false,
+							Max:           0,
+							MinExclusive:  false,
+							MaxExclusive:  false,
+							MultipleOfSet: false,
+							MultipleOf:    0,
+							Pattern:       nil,
+						}).Validate(int64(value)); err != nil {
+							return errors.Wrap(err, "int")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "minNetworks",
+			In:   "query",
+			Err:  err,
+		}
+	}
 	return params, nil
 }
@@ -40,6 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
+	args := [1]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -72,9 +73,223 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "countries"
+				case 'b': // Prefix: "b"
 
-					if l := len("countries"); len(elem) >= l && elem[0:l] == "countries" {
+					if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'o': // Prefix: "ogons"
+
+						if l := len("ogons"); len(elem) >= l && elem[0:l] == "ogons" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetBogonsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "reakdown"
+
+						if l := len("reakdown"); len(elem) >= l && elem[0:l] == "reakdown" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleGetRangeBreakdownRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				case 'c': // Prefix: "c"
+
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "idr_data"
+
+						if l := len("idr_data"); len(elem) >= l && elem[0:l] == "idr_data" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleGetCidrDataRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					case 'o': // Prefix: "ountries"
+
+						if l := len("ountries"); len(elem) >= l && elem[0:l] == "ountries" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetCountriesRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "code"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetCountryRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				case 'd': // Prefix: "dataset"
+
+					if l := len("dataset"); len(elem) >= l && elem[0:l] == "dataset" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetDatasetRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 'e': // Prefix: "exp"
+
+					if l := len("exp"); len(elem) >= l && elem[0:l] == "exp" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "lain"
+
+						if l := len("lain"); len(elem) >= l && elem[0:l] == "lain" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleExplainIpRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "ression"
+
+						if l := len("ression"); len(elem) >= l && elem[0:l] == "ression" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleEvaluateExpressionRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					}
+
+				case 'g': // Prefix: "groups"
+
+					if l := len("groups"); len(elem) >= l && elem[0:l] == "groups" {
 						elem = elem[l:]
 					} else {
 						break
@@ -84,7 +299,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						// Leaf node.
 						switch r.Method {
 						case "GET":
-							s.handleGetCountriesRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleGetGroupsRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
@@ -153,6 +368,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
+				case 'q': // Prefix: "quality"
+
+					if l := len("quality"); len(elem) >= l && elem[0:l] == "quality" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetQualityReportRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
 				}
 
 			case 'v': // Prefix: "v1/health"
@@ -190,7 +425,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [0]string
+	args           [1]string
 }
 
 // Name returns ogen operation name.
@@ -287,9 +522,261 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
-				case 'c': // Prefix: "countries"
+				case 'b': // Prefix: "b"
+
+					if l := len("b"); len(elem) >= l && elem[0:l] == "b" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'o': // Prefix: "ogons"
+
+						if l := len("ogons"); len(elem) >= l && elem[0:l] == "ogons" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetBogonsOperation
+								r.summary = "Список bogon-сетей (не глобально достижимых по реестрам IANA) для фильтрации"
+								r.operationID = "getBogons"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/bogons"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "reakdown"
+
+						if l := len("reakdown"); len(elem) >= l && elem[0:l] == "reakdown" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = GetRangeBreakdownOperation
+								r.summary = "Разбивка диапазонов адресов по странам"
+								r.operationID = "getRangeBreakdown"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/breakdown"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'c': // Prefix: "c"
+
+					if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "idr_data"
+
+						if l := len("idr_data"); len(elem) >= l && elem[0:l] == "idr_data" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = GetCidrDataOperation
+								r.summary = "Поиск подсетей набора данных по перечню CIDR"
+								r.operationID = "getCidrData"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/cidr_data"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'o': // Prefix: "ountries"
+
+						if l := len("ountries"); len(elem) >= l && elem[0:l] == "ountries" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetCountriesOperation
+								r.summary = "Получение полного перечня кодов стран"
+								r.operationID = "getCountries"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/countries"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "code"
+							// Leaf parameter, slashes are prohibited
+							idx := strings.IndexByte(elem, '/')
+							if idx >= 0 {
+								break
+							}
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetCountryOperation
+									r.summary = "Справочные данные ISO 3166 по стране и её доля в наборе данных"
+									r.operationID = "getCountry"
+									r.operationGroup = ""
+									r.pathPattern = "/geo/countries/{code}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				case 'd': // Prefix: "dataset"
+
+					if l := len("dataset"); len(elem) >= l && elem[0:l] == "dataset" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetDatasetOperation
+							r.summary = "Метаданные загруженного набора данных и его источников"
+							r.operationID = "getDataset"
+							r.operationGroup = ""
+							r.pathPattern = "/geo/dataset"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'e': // Prefix: "exp"
+
+					if l := len("exp"); len(elem) >= l && elem[0:l] == "exp" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "lain"
+
+						if l := len("lain"); len(elem) >= l && elem[0:l] == "lain" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ExplainIpOperation
+								r.summary = "Подробный разбор определения страны по ip адресу"
+								r.operationID = "explainIp"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/explain"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "ression"
+
+						if l := len("ression"); len(elem) >= l && elem[0:l] == "ression" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = EvaluateExpressionOperation
+								r.summary = "Вычисление выражения над списками подсетей стран"
+								r.operationID = "evaluateExpression"
+								r.operationGroup = ""
+								r.pathPattern = "/geo/expression"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
 
-					if l := len("countries"); len(elem) >= l && elem[0:l] == "countries" {
+				case 'g': // Prefix: "groups"
+
+					if l := len("groups"); len(elem) >= l && elem[0:l] == "groups" {
 						elem = elem[l:]
 					} else {
 						break
@@ -299,11 +786,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						// Leaf node.
 						switch method {
 						case "GET":
-							r.name = GetCountriesOperation
-							r.summary = "Получение полного перечня кодов стран"
-							r.operationID = "getCountries"
+							r.name = GetGroupsOperation
+							r.summary = "Каталог групп стран (континенты, союзы, пользовательские группы)"
+							r.operationID = "getGroups"
 							r.operationGroup = ""
-							r.pathPattern = "/geo/countries"
+							r.pathPattern = "/geo/groups"
 							r.args = args
 							r.count = 0
 							return r, true
@@ -388,6 +875,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
+				case 'q': // Prefix: "quality"
+
+					if l := len("quality"); len(elem) >= l && elem[0:l] == "quality" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetQualityReportOperation
+							r.summary = "Отчёт о качестве загруженного набора данных"
+							r.operationID = "getQualityReport"
+							r.operationGroup = ""
+							r.pathPattern = "/geo/quality"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
 				}
 
 			case 'v': // Prefix: "v1/health"
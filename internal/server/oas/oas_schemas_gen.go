@@ -4,354 +4,3139 @@ package oas
 
 import (
 	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 )
 
 func (s *DefaultErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
-type Cidr string
+// Класс адреса по реестрам специального назначения IANA:
+// none (обычное
+// адресное пространство), private, loopback, link_local, cgnat (100.64.0.0/10),
+// documentation, multicast, reserved, special (прочие блоки: anycast-сервисы,
+// трансляция, туннели). Для развёрнутого псевдонима
+// (alias) — класс
+// встроенного IPv4-адреса lookupIp.
+// Ref: #/components/schemas/AddressClass
+type AddressClass string
+
+const (
+	AddressClassNone          AddressClass = "none"
+	AddressClassPrivate       AddressClass = "private"
+	AddressClassLoopback      AddressClass = "loopback"
+	AddressClassLinkLocal     AddressClass = "link_local"
+	AddressClassCgnat         AddressClass = "cgnat"
+	AddressClassDocumentation AddressClass = "documentation"
+	AddressClassMulticast     AddressClass = "multicast"
+	AddressClassReserved      AddressClass = "reserved"
+	AddressClassSpecial       AddressClass = "special"
+)
 
-// Ref: #/components/schemas/CountryRangeData
-type CountryRangeData struct {
-	Code        IsoCode `json:"code"`
-	RangesCount int32   `json:"rangesCount"`
+// AllValues returns all AddressClass values.
+func (AddressClass) AllValues() []AddressClass {
+	return []AddressClass{
+		AddressClassNone,
+		AddressClassPrivate,
+		AddressClassLoopback,
+		AddressClassLinkLocal,
+		AddressClassCgnat,
+		AddressClassDocumentation,
+		AddressClassMulticast,
+		AddressClassReserved,
+		AddressClassSpecial,
+	}
 }
 
-// GetCode returns the value of Code.
-func (s *CountryRangeData) GetCode() IsoCode {
-	return s.Code
+// MarshalText implements encoding.TextMarshaler.
+func (s AddressClass) MarshalText() ([]byte, error) {
+	switch s {
+	case AddressClassNone:
+		return []byte(s), nil
+	case AddressClassPrivate:
+		return []byte(s), nil
+	case AddressClassLoopback:
+		return []byte(s), nil
+	case AddressClassLinkLocal:
+		return []byte(s), nil
+	case AddressClassCgnat:
+		return []byte(s), nil
+	case AddressClassDocumentation:
+		return []byte(s), nil
+	case AddressClassMulticast:
+		return []byte(s), nil
+	case AddressClassReserved:
+		return []byte(s), nil
+	case AddressClassSpecial:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetRangesCount returns the value of RangesCount.
-func (s *CountryRangeData) GetRangesCount() int32 {
-	return s.RangesCount
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AddressClass) UnmarshalText(data []byte) error {
+	switch AddressClass(data) {
+	case AddressClassNone:
+		*s = AddressClassNone
+		return nil
+	case AddressClassPrivate:
+		*s = AddressClassPrivate
+		return nil
+	case AddressClassLoopback:
+		*s = AddressClassLoopback
+		return nil
+	case AddressClassLinkLocal:
+		*s = AddressClassLinkLocal
+		return nil
+	case AddressClassCgnat:
+		*s = AddressClassCgnat
+		return nil
+	case AddressClassDocumentation:
+		*s = AddressClassDocumentation
+		return nil
+	case AddressClassMulticast:
+		*s = AddressClassMulticast
+		return nil
+	case AddressClassReserved:
+		*s = AddressClassReserved
+		return nil
+	case AddressClassSpecial:
+		*s = AddressClassSpecial
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetCode sets the value of Code.
-func (s *CountryRangeData) SetCode(val IsoCode) {
-	s.Code = val
+type AddressCount string
+
+// Ref: #/components/schemas/AddressFamily
+type AddressFamily string
+
+const (
+	AddressFamilyIpv4 AddressFamily = "ipv4"
+	AddressFamilyIpv6 AddressFamily = "ipv6"
+)
+
+// AllValues returns all AddressFamily values.
+func (AddressFamily) AllValues() []AddressFamily {
+	return []AddressFamily{
+		AddressFamilyIpv4,
+		AddressFamilyIpv6,
+	}
 }
 
-// SetRangesCount sets the value of RangesCount.
-func (s *CountryRangeData) SetRangesCount(val int32) {
-	s.RangesCount = val
+// MarshalText implements encoding.TextMarshaler.
+func (s AddressFamily) MarshalText() ([]byte, error) {
+	switch s {
+	case AddressFamilyIpv4:
+		return []byte(s), nil
+	case AddressFamilyIpv6:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// DefaultErrorStatusCode wraps ErrorResponse with StatusCode.
-type DefaultErrorStatusCode struct {
-	StatusCode int
-	Response   ErrorResponse
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AddressFamily) UnmarshalText(data []byte) error {
+	switch AddressFamily(data) {
+	case AddressFamilyIpv4:
+		*s = AddressFamilyIpv4
+		return nil
+	case AddressFamilyIpv6:
+		*s = AddressFamilyIpv6
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *DefaultErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// Адресное пространство страны в одном семействе. При
+// вложенных сетях
+// адрес относится к самой узкой из них, как при поиске.
+// Ref: #/components/schemas/AddressSpace
+type AddressSpace struct {
+	Networks  int32        `json:"networks"`
+	Addresses AddressCount `json:"addresses"`
+	// Объём в /64 с округлением вниз (только IPv6).
+	Slash64s OptInt64 `json:"slash64s"`
+	// Доля пространства семейства в наборе данных, %.
+	Percent float64 `json:"percent"`
+	// Гистограмма длин префиксов, от коротких к длинным
+	// (пустые длины опущены).
+	PrefixLengths []PrefixLengthCount `json:"prefixLengths"`
 }
 
-// GetResponse returns the value of Response.
-func (s *DefaultErrorStatusCode) GetResponse() ErrorResponse {
-	return s.Response
+// GetNetworks returns the value of Networks.
+func (s *AddressSpace) GetNetworks() int32 {
+	return s.Networks
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *DefaultErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// GetAddresses returns the value of Addresses.
+func (s *AddressSpace) GetAddresses() AddressCount {
+	return s.Addresses
 }
 
-// SetResponse sets the value of Response.
-func (s *DefaultErrorStatusCode) SetResponse(val ErrorResponse) {
-	s.Response = val
+// GetSlash64s returns the value of Slash64s.
+func (s *AddressSpace) GetSlash64s() OptInt64 {
+	return s.Slash64s
 }
 
-// Ref: #/components/schemas/ErrorResponse
-type ErrorResponse struct {
-	// Result of the request execution (e.g., "ERROR" in case of failure).
-	Result string `json:"result"`
-	// Response data (null in case of an error).
-	Content OptErrorResponseContent `json:"content"`
-	Error   ErrorResponseError      `json:"error"`
+// GetPercent returns the value of Percent.
+func (s *AddressSpace) GetPercent() float64 {
+	return s.Percent
 }
 
-// GetResult returns the value of Result.
-func (s *ErrorResponse) GetResult() string {
-	return s.Result
+// GetPrefixLengths returns the value of PrefixLengths.
+func (s *AddressSpace) GetPrefixLengths() []PrefixLengthCount {
+	return s.PrefixLengths
 }
 
-// GetContent returns the value of Content.
-func (s *ErrorResponse) GetContent() OptErrorResponseContent {
-	return s.Content
+// SetNetworks sets the value of Networks.
+func (s *AddressSpace) SetNetworks(val int32) {
+	s.Networks = val
 }
 
-// GetError returns the value of Error.
-func (s *ErrorResponse) GetError() ErrorResponseError {
-	return s.Error
+// SetAddresses sets the value of Addresses.
+func (s *AddressSpace) SetAddresses(val AddressCount) {
+	s.Addresses = val
 }
 
-// SetResult sets the value of Result.
-func (s *ErrorResponse) SetResult(val string) {
-	s.Result = val
+// SetSlash64s sets the value of Slash64s.
+func (s *AddressSpace) SetSlash64s(val OptInt64) {
+	s.Slash64s = val
 }
 
-// SetContent sets the value of Content.
-func (s *ErrorResponse) SetContent(val OptErrorResponseContent) {
-	s.Content = val
+// SetPercent sets the value of Percent.
+func (s *AddressSpace) SetPercent(val float64) {
+	s.Percent = val
 }
 
-// SetError sets the value of Error.
-func (s *ErrorResponse) SetError(val ErrorResponseError) {
-	s.Error = val
+// SetPrefixLengths sets the value of PrefixLengths.
+func (s *AddressSpace) SetPrefixLengths(val []PrefixLengthCount) {
+	s.PrefixLengths = val
 }
 
-// Response data (null in case of an error).
-type ErrorResponseContent struct{}
+// Ref: #/components/schemas/AliasConflict
+type AliasConflict struct {
+	Network     Cidr               `json:"network"`
+	Code        IsoCode            `json:"code"`
+	Alias       AliasConflictAlias `json:"alias"`
+	Ipv4Network Cidr               `json:"ipv4Network"`
+	Ipv4Code    IsoCode            `json:"ipv4Code"`
+}
 
-type ErrorResponseError struct {
-	// Error code.
-	Code string `json:"code"`
-	// Error description.
-	Description string `json:"description"`
+// GetNetwork returns the value of Network.
+func (s *AliasConflict) GetNetwork() Cidr {
+	return s.Network
 }
 
 // GetCode returns the value of Code.
-func (s *ErrorResponseError) GetCode() string {
+func (s *AliasConflict) GetCode() IsoCode {
 	return s.Code
 }
 
-// GetDescription returns the value of Description.
-func (s *ErrorResponseError) GetDescription() string {
-	return s.Description
+// GetAlias returns the value of Alias.
+func (s *AliasConflict) GetAlias() AliasConflictAlias {
+	return s.Alias
+}
+
+// GetIpv4Network returns the value of Ipv4Network.
+func (s *AliasConflict) GetIpv4Network() Cidr {
+	return s.Ipv4Network
+}
+
+// GetIpv4Code returns the value of Ipv4Code.
+func (s *AliasConflict) GetIpv4Code() IsoCode {
+	return s.Ipv4Code
+}
+
+// SetNetwork sets the value of Network.
+func (s *AliasConflict) SetNetwork(val Cidr) {
+	s.Network = val
 }
 
 // SetCode sets the value of Code.
-func (s *ErrorResponseError) SetCode(val string) {
+func (s *AliasConflict) SetCode(val IsoCode) {
 	s.Code = val
 }
 
-// SetDescription sets the value of Description.
-func (s *ErrorResponseError) SetDescription(val string) {
-	s.Description = val
+// SetAlias sets the value of Alias.
+func (s *AliasConflict) SetAlias(val AliasConflictAlias) {
+	s.Alias = val
 }
 
-// Ref: #/components/schemas/GeoIpData
-type GeoIpData struct {
-	IP   IpAddress `json:"ip"`
-	Code IsoCode   `json:"code"`
-	// Optional (если появится источник имени страны).
-	CountryName OptNilString `json:"countryName"`
+// SetIpv4Network sets the value of Ipv4Network.
+func (s *AliasConflict) SetIpv4Network(val Cidr) {
+	s.Ipv4Network = val
 }
 
-// GetIP returns the value of IP.
-func (s *GeoIpData) GetIP() IpAddress {
-	return s.IP
+// SetIpv4Code sets the value of Ipv4Code.
+func (s *AliasConflict) SetIpv4Code(val IsoCode) {
+	s.Ipv4Code = val
 }
 
-// GetCode returns the value of Code.
-func (s *GeoIpData) GetCode() IsoCode {
-	return s.Code
+type AliasConflictAlias string
+
+const (
+	AliasConflictAliasIpv4Mapped AliasConflictAlias = "ipv4_mapped"
+	AliasConflictAlias6to4       AliasConflictAlias = "6to4"
+	AliasConflictAliasTeredo     AliasConflictAlias = "teredo"
+)
+
+// AllValues returns all AliasConflictAlias values.
+func (AliasConflictAlias) AllValues() []AliasConflictAlias {
+	return []AliasConflictAlias{
+		AliasConflictAliasIpv4Mapped,
+		AliasConflictAlias6to4,
+		AliasConflictAliasTeredo,
+	}
 }
 
-// GetCountryName returns the value of CountryName.
-func (s *GeoIpData) GetCountryName() OptNilString {
-	return s.CountryName
+// MarshalText implements encoding.TextMarshaler.
+func (s AliasConflictAlias) MarshalText() ([]byte, error) {
+	switch s {
+	case AliasConflictAliasIpv4Mapped:
+		return []byte(s), nil
+	case AliasConflictAlias6to4:
+		return []byte(s), nil
+	case AliasConflictAliasTeredo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetIP sets the value of IP.
-func (s *GeoIpData) SetIP(val IpAddress) {
-	s.IP = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AliasConflictAlias) UnmarshalText(data []byte) error {
+	switch AliasConflictAlias(data) {
+	case AliasConflictAliasIpv4Mapped:
+		*s = AliasConflictAliasIpv4Mapped
+		return nil
+	case AliasConflictAlias6to4:
+		*s = AliasConflictAlias6to4
+		return nil
+	case AliasConflictAliasTeredo:
+		*s = AliasConflictAliasTeredo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetCode sets the value of Code.
-func (s *GeoIpData) SetCode(val IsoCode) {
-	s.Code = val
+// Ref: #/components/schemas/AliasInfo
+type AliasInfo struct {
+	Kind         AliasInfoKind `json:"kind"`
+	EmbeddedIpv4 OptIpAddress  `json:"embeddedIpv4"`
+	// Поиск выполнялся по вложенному IPv4 адресу.
+	Applied bool `json:"applied"`
 }
 
-// SetCountryName sets the value of CountryName.
-func (s *GeoIpData) SetCountryName(val OptNilString) {
-	s.CountryName = val
+// GetKind returns the value of Kind.
+func (s *AliasInfo) GetKind() AliasInfoKind {
+	return s.Kind
 }
 
-// Ref: #/components/schemas/GeoPayload
-type GeoPayload struct {
-	Ips []IpPayload `json:"ips"`
+// GetEmbeddedIpv4 returns the value of EmbeddedIpv4.
+func (s *AliasInfo) GetEmbeddedIpv4() OptIpAddress {
+	return s.EmbeddedIpv4
 }
 
-// GetIps returns the value of Ips.
-func (s *GeoPayload) GetIps() []IpPayload {
-	return s.Ips
+// GetApplied returns the value of Applied.
+func (s *AliasInfo) GetApplied() bool {
+	return s.Applied
 }
 
-// SetIps sets the value of Ips.
-func (s *GeoPayload) SetIps(val []IpPayload) {
-	s.Ips = val
+// SetKind sets the value of Kind.
+func (s *AliasInfo) SetKind(val AliasInfoKind) {
+	s.Kind = val
 }
 
-type GetCountriesBadRequest ErrorResponse
+// SetEmbeddedIpv4 sets the value of EmbeddedIpv4.
+func (s *AliasInfo) SetEmbeddedIpv4(val OptIpAddress) {
+	s.EmbeddedIpv4 = val
+}
 
-func (*GetCountriesBadRequest) getCountriesRes() {}
+// SetApplied sets the value of Applied.
+func (s *AliasInfo) SetApplied(val bool) {
+	s.Applied = val
+}
 
-type GetCountriesInternalServerError ErrorResponse
+type AliasInfoKind string
 
-func (*GetCountriesInternalServerError) getCountriesRes() {}
+const (
+	AliasInfoKindNone       AliasInfoKind = "none"
+	AliasInfoKindIpv4Mapped AliasInfoKind = "ipv4_mapped"
+	AliasInfoKind6to4       AliasInfoKind = "6to4"
+	AliasInfoKindTeredo     AliasInfoKind = "teredo"
+)
 
-type GetCountriesNotFound ErrorResponse
+// AllValues returns all AliasInfoKind values.
+func (AliasInfoKind) AllValues() []AliasInfoKind {
+	return []AliasInfoKind{
+		AliasInfoKindNone,
+		AliasInfoKindIpv4Mapped,
+		AliasInfoKind6to4,
+		AliasInfoKindTeredo,
+	}
+}
 
-func (*GetCountriesNotFound) getCountriesRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s AliasInfoKind) MarshalText() ([]byte, error) {
+	switch s {
+	case AliasInfoKindNone:
+		return []byte(s), nil
+	case AliasInfoKindIpv4Mapped:
+		return []byte(s), nil
+	case AliasInfoKind6to4:
+		return []byte(s), nil
+	case AliasInfoKindTeredo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type GetCountriesOKApplicationJSON []CountryRangeData
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AliasInfoKind) UnmarshalText(data []byte) error {
+	switch AliasInfoKind(data) {
+	case AliasInfoKindNone:
+		*s = AliasInfoKindNone
+		return nil
+	case AliasInfoKindIpv4Mapped:
+		*s = AliasInfoKindIpv4Mapped
+		return nil
+	case AliasInfoKind6to4:
+		*s = AliasInfoKind6to4
+		return nil
+	case AliasInfoKindTeredo:
+		*s = AliasInfoKindTeredo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*GetCountriesOKApplicationJSON) getCountriesRes() {}
+// Какую страну использовать: located — страну
+// расположения (по умолчанию), registered — registered_country
+// (страну регистрации блока); локальные
+// переопределения действуют в обоих случаях.
+// Ref: #/components/schemas/Basis
+type Basis string
 
-type GetCountryNetworksBadRequest ErrorResponse
+const (
+	BasisLocated    Basis = "located"
+	BasisRegistered Basis = "registered"
+)
 
-func (*GetCountryNetworksBadRequest) getCountryNetworksRes() {}
+// AllValues returns all Basis values.
+func (Basis) AllValues() []Basis {
+	return []Basis{
+		BasisLocated,
+		BasisRegistered,
+	}
+}
 
-type GetCountryNetworksInternalServerError ErrorResponse
+// MarshalText implements encoding.TextMarshaler.
+func (s Basis) MarshalText() ([]byte, error) {
+	switch s {
+	case BasisLocated:
+		return []byte(s), nil
+	case BasisRegistered:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-func (*GetCountryNetworksInternalServerError) getCountryNetworksRes() {}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Basis) UnmarshalText(data []byte) error {
+	switch Basis(data) {
+	case BasisLocated:
+		*s = BasisLocated
+		return nil
+	case BasisRegistered:
+		*s = BasisRegistered
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-type GetCountryNetworksNotFound ErrorResponse
+// Ref: #/components/schemas/BogonList
+type BogonList struct {
+	Family AddressFamily `json:"family"`
+	// Минимальный список CIDR в порядке адресов.
+	Networks      []Cidr `json:"networks"`
+	TotalNetworks int64  `json:"totalNetworks"`
+}
 
-func (*GetCountryNetworksNotFound) getCountryNetworksRes() {}
+// GetFamily returns the value of Family.
+func (s *BogonList) GetFamily() AddressFamily {
+	return s.Family
+}
 
-type GetCountryNetworksOKApplicationJSON []IsoCodeNetworks
+// GetNetworks returns the value of Networks.
+func (s *BogonList) GetNetworks() []Cidr {
+	return s.Networks
+}
 
-func (*GetCountryNetworksOKApplicationJSON) getCountryNetworksRes() {}
+// GetTotalNetworks returns the value of TotalNetworks.
+func (s *BogonList) GetTotalNetworks() int64 {
+	return s.TotalNetworks
+}
 
-type GetCountryNetworksPagedBadRequest ErrorResponse
+// SetFamily sets the value of Family.
+func (s *BogonList) SetFamily(val AddressFamily) {
+	s.Family = val
+}
 
-func (*GetCountryNetworksPagedBadRequest) getCountryNetworksPagedRes() {}
+// SetNetworks sets the value of Networks.
+func (s *BogonList) SetNetworks(val []Cidr) {
+	s.Networks = val
+}
 
-type GetCountryNetworksPagedInternalServerError ErrorResponse
+// SetTotalNetworks sets the value of TotalNetworks.
+func (s *BogonList) SetTotalNetworks(val int64) {
+	s.TotalNetworks = val
+}
 
-func (*GetCountryNetworksPagedInternalServerError) getCountryNetworksPagedRes() {}
+func (*BogonList) getBogonsRes() {}
 
-type GetCountryNetworksPagedNotFound ErrorResponse
+// Ref: #/components/schemas/BreakdownPayload
+type BreakdownPayload struct {
+	Ranges []string `json:"ranges"`
+}
 
-func (*GetCountryNetworksPagedNotFound) getCountryNetworksPagedRes() {}
+// GetRanges returns the value of Ranges.
+func (s *BreakdownPayload) GetRanges() []string {
+	return s.Ranges
+}
 
-type GetIpDataBadRequest ErrorResponse
+// SetRanges sets the value of Ranges.
+func (s *BreakdownPayload) SetRanges(val []string) {
+	s.Ranges = val
+}
 
-func (*GetIpDataBadRequest) getIpDataRes() {}
+type Cidr string
 
-type GetIpDataInternalServerError ErrorResponse
+// Ref: #/components/schemas/CidrData
+type CidrData struct {
+	// Запрошенный CIDR с обнулёнными битами хоста.
+	Cidr Cidr `json:"cidr"`
+	// Подсеть набора, совпадающая с CIDR (отсутствует, если
+	// такой нет).
+	Exact OptNetworkData `json:"exact"`
+	// Подсети набора, содержащие CIDR, от самой широкой.
+	Supernets []NetworkData `json:"supernets"`
+	// Страница подсетей набора, содержащихся в CIDR, в
+	// порядке адресов.
+	Subnets      []NetworkData `json:"subnets"`
+	TotalSubnets int64         `json:"totalSubnets"`
+	TotalPages   int64         `json:"totalPages"`
+	Size         int64         `json:"size"`
+	Page         int64         `json:"page"`
+}
 
-func (*GetIpDataInternalServerError) getIpDataRes() {}
+// GetCidr returns the value of Cidr.
+func (s *CidrData) GetCidr() Cidr {
+	return s.Cidr
+}
 
-type GetIpDataNotFound ErrorResponse
+// GetExact returns the value of Exact.
+func (s *CidrData) GetExact() OptNetworkData {
+	return s.Exact
+}
 
-func (*GetIpDataNotFound) getIpDataRes() {}
+// GetSupernets returns the value of Supernets.
+func (s *CidrData) GetSupernets() []NetworkData {
+	return s.Supernets
+}
 
-type GetIpDataOKApplicationJSON []GeoIpData
+// GetSubnets returns the value of Subnets.
+func (s *CidrData) GetSubnets() []NetworkData {
+	return s.Subnets
+}
 
-func (*GetIpDataOKApplicationJSON) getIpDataRes() {}
+// GetTotalSubnets returns the value of TotalSubnets.
+func (s *CidrData) GetTotalSubnets() int64 {
+	return s.TotalSubnets
+}
 
-// Service health status.
-// Ref: #/components/schemas/Health
-type Health struct {
-	// Service uptime (seconds).
-	Uptime int `json:"uptime"`
-	// Service version.
-	Version string `json:"version"`
+// GetTotalPages returns the value of TotalPages.
+func (s *CidrData) GetTotalPages() int64 {
+	return s.TotalPages
 }
 
-// GetUptime returns the value of Uptime.
-func (s *Health) GetUptime() int {
-	return s.Uptime
+// GetSize returns the value of Size.
+func (s *CidrData) GetSize() int64 {
+	return s.Size
 }
 
-// GetVersion returns the value of Version.
-func (s *Health) GetVersion() string {
-	return s.Version
+// GetPage returns the value of Page.
+func (s *CidrData) GetPage() int64 {
+	return s.Page
 }
 
-// SetUptime sets the value of Uptime.
-func (s *Health) SetUptime(val int) {
-	s.Uptime = val
+// SetCidr sets the value of Cidr.
+func (s *CidrData) SetCidr(val Cidr) {
+	s.Cidr = val
 }
 
-// SetVersion sets the value of Version.
-func (s *Health) SetVersion(val string) {
-	s.Version = val
+// SetExact sets the value of Exact.
+func (s *CidrData) SetExact(val OptNetworkData) {
+	s.Exact = val
 }
 
-type IpAddress string
+// SetSupernets sets the value of Supernets.
+func (s *CidrData) SetSupernets(val []NetworkData) {
+	s.Supernets = val
+}
 
-// Ref: #/components/schemas/IpPayload
-type IpPayload struct {
-	IP IpAddress `json:"ip"`
+// SetSubnets sets the value of Subnets.
+func (s *CidrData) SetSubnets(val []NetworkData) {
+	s.Subnets = val
 }
 
-// GetIP returns the value of IP.
-func (s *IpPayload) GetIP() IpAddress {
-	return s.IP
+// SetTotalSubnets sets the value of TotalSubnets.
+func (s *CidrData) SetTotalSubnets(val int64) {
+	s.TotalSubnets = val
 }
 
-// SetIP sets the value of IP.
-func (s *IpPayload) SetIP(val IpAddress) {
-	s.IP = val
+// SetTotalPages sets the value of TotalPages.
+func (s *CidrData) SetTotalPages(val int64) {
+	s.TotalPages = val
+}
+
+// SetSize sets the value of Size.
+func (s *CidrData) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetPage sets the value of Page.
+func (s *CidrData) SetPage(val int64) {
+	s.Page = val
+}
+
+// Ref: #/components/schemas/CidrPayload
+type CidrPayload struct {
+	Cidrs []Cidr `json:"cidrs"`
+	// Номер страницы вложенных подсетей (0..), одинаковый для
+	// всех CIDR.
+	Page OptInt32 `json:"page"`
+	// Размер страницы вложенных подсетей.
+	Size OptInt32 `json:"size"`
+}
+
+// GetCidrs returns the value of Cidrs.
+func (s *CidrPayload) GetCidrs() []Cidr {
+	return s.Cidrs
+}
+
+// GetPage returns the value of Page.
+func (s *CidrPayload) GetPage() OptInt32 {
+	return s.Page
+}
+
+// GetSize returns the value of Size.
+func (s *CidrPayload) GetSize() OptInt32 {
+	return s.Size
+}
+
+// SetCidrs sets the value of Cidrs.
+func (s *CidrPayload) SetCidrs(val []Cidr) {
+	s.Cidrs = val
+}
+
+// SetPage sets the value of Page.
+func (s *CidrPayload) SetPage(val OptInt32) {
+	s.Page = val
+}
+
+// SetSize sets the value of Size.
+func (s *CidrPayload) SetSize(val OptInt32) {
+	s.Size = val
+}
+
+// Поле записи, из которого получен code.
+// Ref: #/components/schemas/CodeSource
+type CodeSource string
+
+const (
+	CodeSourceCountry            CodeSource = "country"
+	CodeSourceRegisteredCountry  CodeSource = "registered_country"
+	CodeSourceRepresentedCountry CodeSource = "represented_country"
+	CodeSourceFallback           CodeSource = "fallback"
+	CodeSourceOverride           CodeSource = "override"
+)
+
+// AllValues returns all CodeSource values.
+func (CodeSource) AllValues() []CodeSource {
+	return []CodeSource{
+		CodeSourceCountry,
+		CodeSourceRegisteredCountry,
+		CodeSourceRepresentedCountry,
+		CodeSourceFallback,
+		CodeSourceOverride,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CodeSource) MarshalText() ([]byte, error) {
+	switch s {
+	case CodeSourceCountry:
+		return []byte(s), nil
+	case CodeSourceRegisteredCountry:
+		return []byte(s), nil
+	case CodeSourceRepresentedCountry:
+		return []byte(s), nil
+	case CodeSourceFallback:
+		return []byte(s), nil
+	case CodeSourceOverride:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CodeSource) UnmarshalText(data []byte) error {
+	switch CodeSource(data) {
+	case CodeSourceCountry:
+		*s = CodeSourceCountry
+		return nil
+	case CodeSourceRegisteredCountry:
+		*s = CodeSourceRegisteredCountry
+		return nil
+	case CodeSourceRepresentedCountry:
+		*s = CodeSourceRepresentedCountry
+		return nil
+	case CodeSourceFallback:
+		*s = CodeSourceFallback
+		return nil
+	case CodeSourceOverride:
+		*s = CodeSourceOverride
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CountryChange
+type CountryChange struct {
+	Code   IsoCode      `json:"code"`
+	Before CountryCount `json:"before"`
+	After  CountryCount `json:"after"`
+	// Наибольшее относительное изменение счётчиков, %;
+	// новая или исчезнувшая страна даёт ±100.
+	Percent float64 `json:"percent"`
+}
+
+// GetCode returns the value of Code.
+func (s *CountryChange) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetBefore returns the value of Before.
+func (s *CountryChange) GetBefore() CountryCount {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *CountryChange) GetAfter() CountryCount {
+	return s.After
+}
+
+// GetPercent returns the value of Percent.
+func (s *CountryChange) GetPercent() float64 {
+	return s.Percent
+}
+
+// SetCode sets the value of Code.
+func (s *CountryChange) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetBefore sets the value of Before.
+func (s *CountryChange) SetBefore(val CountryCount) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *CountryChange) SetAfter(val CountryCount) {
+	s.After = val
+}
+
+// SetPercent sets the value of Percent.
+func (s *CountryChange) SetPercent(val float64) {
+	s.Percent = val
+}
+
+type CountryCode string
+
+// Ref: #/components/schemas/CountryCount
+type CountryCount struct {
+	Networks      int64 `json:"networks"`
+	Ipv4Addresses int64 `json:"ipv4Addresses"`
+	Ipv6Slash64s  int64 `json:"ipv6Slash64s"`
+}
+
+// GetNetworks returns the value of Networks.
+func (s *CountryCount) GetNetworks() int64 {
+	return s.Networks
+}
+
+// GetIpv4Addresses returns the value of Ipv4Addresses.
+func (s *CountryCount) GetIpv4Addresses() int64 {
+	return s.Ipv4Addresses
+}
+
+// GetIpv6Slash64s returns the value of Ipv6Slash64s.
+func (s *CountryCount) GetIpv6Slash64s() int64 {
+	return s.Ipv6Slash64s
+}
+
+// SetNetworks sets the value of Networks.
+func (s *CountryCount) SetNetworks(val int64) {
+	s.Networks = val
+}
+
+// SetIpv4Addresses sets the value of Ipv4Addresses.
+func (s *CountryCount) SetIpv4Addresses(val int64) {
+	s.Ipv4Addresses = val
+}
+
+// SetIpv6Slash64s sets the value of Ipv6Slash64s.
+func (s *CountryCount) SetIpv6Slash64s(val int64) {
+	s.Ipv6Slash64s = val
+}
+
+// Ref: #/components/schemas/CountryGroup
+type CountryGroup struct {
+	ID      string           `json:"id"`
+	Name    string           `json:"name"`
+	Kind    CountryGroupKind `json:"kind"`
+	Members []IsoCode        `json:"members"`
+}
+
+// GetID returns the value of ID.
+func (s *CountryGroup) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *CountryGroup) GetName() string {
+	return s.Name
+}
+
+// GetKind returns the value of Kind.
+func (s *CountryGroup) GetKind() CountryGroupKind {
+	return s.Kind
+}
+
+// GetMembers returns the value of Members.
+func (s *CountryGroup) GetMembers() []IsoCode {
+	return s.Members
+}
+
+// SetID sets the value of ID.
+func (s *CountryGroup) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *CountryGroup) SetName(val string) {
+	s.Name = val
+}
+
+// SetKind sets the value of Kind.
+func (s *CountryGroup) SetKind(val CountryGroupKind) {
+	s.Kind = val
+}
+
+// SetMembers sets the value of Members.
+func (s *CountryGroup) SetMembers(val []IsoCode) {
+	s.Members = val
+}
+
+type CountryGroupKind string
+
+const (
+	CountryGroupKindContinent CountryGroupKind = "continent"
+	CountryGroupKindUnion     CountryGroupKind = "union"
+	CountryGroupKindCustom    CountryGroupKind = "custom"
+)
+
+// AllValues returns all CountryGroupKind values.
+func (CountryGroupKind) AllValues() []CountryGroupKind {
+	return []CountryGroupKind{
+		CountryGroupKindContinent,
+		CountryGroupKindUnion,
+		CountryGroupKindCustom,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CountryGroupKind) MarshalText() ([]byte, error) {
+	switch s {
+	case CountryGroupKindContinent:
+		return []byte(s), nil
+	case CountryGroupKindUnion:
+		return []byte(s), nil
+	case CountryGroupKindCustom:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CountryGroupKind) UnmarshalText(data []byte) error {
+	switch CountryGroupKind(data) {
+	case CountryGroupKindContinent:
+		*s = CountryGroupKindContinent
+		return nil
+	case CountryGroupKindUnion:
+		*s = CountryGroupKindUnion
+		return nil
+	case CountryGroupKindCustom:
+		*s = CountryGroupKindCustom
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/CountryInfo
+type CountryInfo struct {
+	Code IsoCode `json:"code"`
+	// ISO 3166-1 alpha-3 (отсутствует для кодов вне ISO 3166, например ZZ).
+	Alpha3 OptString `json:"alpha3"`
+	// ISO 3166-1 numeric.
+	Numeric OptString `json:"numeric"`
+	Name    OptString `json:"name"`
+	// Континент, как в группах континентов.
+	Continent OptString `json:"continent"`
+	// Субрегион по классификации ООН (M49).
+	Region OptString    `json:"region"`
+	Ipv4   AddressSpace `json:"ipv4"`
+	Ipv6   AddressSpace `json:"ipv6"`
+}
+
+// GetCode returns the value of Code.
+func (s *CountryInfo) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetAlpha3 returns the value of Alpha3.
+func (s *CountryInfo) GetAlpha3() OptString {
+	return s.Alpha3
+}
+
+// GetNumeric returns the value of Numeric.
+func (s *CountryInfo) GetNumeric() OptString {
+	return s.Numeric
+}
+
+// GetName returns the value of Name.
+func (s *CountryInfo) GetName() OptString {
+	return s.Name
+}
+
+// GetContinent returns the value of Continent.
+func (s *CountryInfo) GetContinent() OptString {
+	return s.Continent
+}
+
+// GetRegion returns the value of Region.
+func (s *CountryInfo) GetRegion() OptString {
+	return s.Region
+}
+
+// GetIpv4 returns the value of Ipv4.
+func (s *CountryInfo) GetIpv4() AddressSpace {
+	return s.Ipv4
+}
+
+// GetIpv6 returns the value of Ipv6.
+func (s *CountryInfo) GetIpv6() AddressSpace {
+	return s.Ipv6
+}
+
+// SetCode sets the value of Code.
+func (s *CountryInfo) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetAlpha3 sets the value of Alpha3.
+func (s *CountryInfo) SetAlpha3(val OptString) {
+	s.Alpha3 = val
+}
+
+// SetNumeric sets the value of Numeric.
+func (s *CountryInfo) SetNumeric(val OptString) {
+	s.Numeric = val
+}
+
+// SetName sets the value of Name.
+func (s *CountryInfo) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetContinent sets the value of Continent.
+func (s *CountryInfo) SetContinent(val OptString) {
+	s.Continent = val
+}
+
+// SetRegion sets the value of Region.
+func (s *CountryInfo) SetRegion(val OptString) {
+	s.Region = val
+}
+
+// SetIpv4 sets the value of Ipv4.
+func (s *CountryInfo) SetIpv4(val AddressSpace) {
+	s.Ipv4 = val
+}
+
+// SetIpv6 sets the value of Ipv6.
+func (s *CountryInfo) SetIpv6(val AddressSpace) {
+	s.Ipv6 = val
+}
+
+func (*CountryInfo) getCountryRes() {}
+
+type CountryOrGroup string
+
+// Ref: #/components/schemas/CountryRangeData
+type CountryRangeData struct {
+	Code IsoCode `json:"code"`
+	// Число сетей (префиксов) любой длины.
+	RangesCount int32 `json:"rangesCount"`
+	// Число IPv4 адресов страны (при вложенных сетях адрес
+	// относится к самой узкой, как при поиске).
+	Ipv4Addresses int64 `json:"ipv4Addresses"`
+	// Объём IPv6 пространства страны в /64, с округлением вниз.
+	Ipv6Slash64s int64 `json:"ipv6Slash64s"`
+	// Доля IPv4 пространства набора данных, %.
+	Ipv4Percent float64 `json:"ipv4Percent"`
+	// Доля IPv6 пространства набора данных, %.
+	Ipv6Percent float64 `json:"ipv6Percent"`
+}
+
+// GetCode returns the value of Code.
+func (s *CountryRangeData) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetRangesCount returns the value of RangesCount.
+func (s *CountryRangeData) GetRangesCount() int32 {
+	return s.RangesCount
+}
+
+// GetIpv4Addresses returns the value of Ipv4Addresses.
+func (s *CountryRangeData) GetIpv4Addresses() int64 {
+	return s.Ipv4Addresses
+}
+
+// GetIpv6Slash64s returns the value of Ipv6Slash64s.
+func (s *CountryRangeData) GetIpv6Slash64s() int64 {
+	return s.Ipv6Slash64s
+}
+
+// GetIpv4Percent returns the value of Ipv4Percent.
+func (s *CountryRangeData) GetIpv4Percent() float64 {
+	return s.Ipv4Percent
+}
+
+// GetIpv6Percent returns the value of Ipv6Percent.
+func (s *CountryRangeData) GetIpv6Percent() float64 {
+	return s.Ipv6Percent
+}
+
+// SetCode sets the value of Code.
+func (s *CountryRangeData) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetRangesCount sets the value of RangesCount.
+func (s *CountryRangeData) SetRangesCount(val int32) {
+	s.RangesCount = val
+}
+
+// SetIpv4Addresses sets the value of Ipv4Addresses.
+func (s *CountryRangeData) SetIpv4Addresses(val int64) {
+	s.Ipv4Addresses = val
+}
+
+// SetIpv6Slash64s sets the value of Ipv6Slash64s.
+func (s *CountryRangeData) SetIpv6Slash64s(val int64) {
+	s.Ipv6Slash64s = val
+}
+
+// SetIpv4Percent sets the value of Ipv4Percent.
+func (s *CountryRangeData) SetIpv4Percent(val float64) {
+	s.Ipv4Percent = val
+}
+
+// SetIpv6Percent sets the value of Ipv6Percent.
+func (s *CountryRangeData) SetIpv6Percent(val float64) {
+	s.Ipv6Percent = val
+}
+
+// Ref: #/components/schemas/CountryShare
+type CountryShare struct {
+	Code IsoCode `json:"code"`
+	// Подсети набора, отвечающие за часть диапазона (могут
+	// выходить за его границы).
+	Networks  []Cidr       `json:"networks"`
+	Addresses AddressCount `json:"addresses"`
+	// Доля диапазона, %.
+	Percent float64 `json:"percent"`
+}
+
+// GetCode returns the value of Code.
+func (s *CountryShare) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetNetworks returns the value of Networks.
+func (s *CountryShare) GetNetworks() []Cidr {
+	return s.Networks
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *CountryShare) GetAddresses() AddressCount {
+	return s.Addresses
+}
+
+// GetPercent returns the value of Percent.
+func (s *CountryShare) GetPercent() float64 {
+	return s.Percent
+}
+
+// SetCode sets the value of Code.
+func (s *CountryShare) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetNetworks sets the value of Networks.
+func (s *CountryShare) SetNetworks(val []Cidr) {
+	s.Networks = val
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *CountryShare) SetAddresses(val AddressCount) {
+	s.Addresses = val
+}
+
+// SetPercent sets the value of Percent.
+func (s *CountryShare) SetPercent(val float64) {
+	s.Percent = val
+}
+
+// Ref: #/components/schemas/DatasetInfo
+type DatasetInfo struct {
+	Type        string    `json:"type"`
+	BuildTime   time.Time `json:"buildTime"`
+	Description OptString `json:"description"`
+}
+
+// GetType returns the value of Type.
+func (s *DatasetInfo) GetType() string {
+	return s.Type
+}
+
+// GetBuildTime returns the value of BuildTime.
+func (s *DatasetInfo) GetBuildTime() time.Time {
+	return s.BuildTime
+}
+
+// GetDescription returns the value of Description.
+func (s *DatasetInfo) GetDescription() OptString {
+	return s.Description
+}
+
+// SetType sets the value of Type.
+func (s *DatasetInfo) SetType(val string) {
+	s.Type = val
+}
+
+// SetBuildTime sets the value of BuildTime.
+func (s *DatasetInfo) SetBuildTime(val time.Time) {
+	s.BuildTime = val
+}
+
+// SetDescription sets the value of Description.
+func (s *DatasetInfo) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// Ref: #/components/schemas/DatasetMetadata
+type DatasetMetadata struct {
+	Dataset DatasetInfo `json:"dataset"`
+	// Источники в порядке приоритета (первый побеждает).
+	Sources []SourceInfo `json:"sources"`
+}
+
+// GetDataset returns the value of Dataset.
+func (s *DatasetMetadata) GetDataset() DatasetInfo {
+	return s.Dataset
+}
+
+// GetSources returns the value of Sources.
+func (s *DatasetMetadata) GetSources() []SourceInfo {
+	return s.Sources
+}
+
+// SetDataset sets the value of Dataset.
+func (s *DatasetMetadata) SetDataset(val DatasetInfo) {
+	s.Dataset = val
+}
+
+// SetSources sets the value of Sources.
+func (s *DatasetMetadata) SetSources(val []SourceInfo) {
+	s.Sources = val
+}
+
+func (*DatasetMetadata) getDatasetRes() {}
+
+// DefaultErrorStatusCode wraps ErrorResponse with StatusCode.
+type DefaultErrorStatusCode struct {
+	StatusCode int
+	Response   ErrorResponse
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *DefaultErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *DefaultErrorStatusCode) GetResponse() ErrorResponse {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *DefaultErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *DefaultErrorStatusCode) SetResponse(val ErrorResponse) {
+	s.Response = val
+}
+
+// Ref: #/components/schemas/ErrorResponse
+type ErrorResponse struct {
+	// Result of the request execution (e.g., "ERROR" in case of failure).
+	Result string `json:"result"`
+	// Response data (null in case of an error).
+	Content OptErrorResponseContent `json:"content"`
+	Error   ErrorResponseError      `json:"error"`
+}
+
+// GetResult returns the value of Result.
+func (s *ErrorResponse) GetResult() string {
+	return s.Result
+}
+
+// GetContent returns the value of Content.
+func (s *ErrorResponse) GetContent() OptErrorResponseContent {
+	return s.Content
+}
+
+// GetError returns the value of Error.
+func (s *ErrorResponse) GetError() ErrorResponseError {
+	return s.Error
+}
+
+// SetResult sets the value of Result.
+func (s *ErrorResponse) SetResult(val string) {
+	s.Result = val
+}
+
+// SetContent sets the value of Content.
+func (s *ErrorResponse) SetContent(val OptErrorResponseContent) {
+	s.Content = val
+}
+
+// SetError sets the value of Error.
+func (s *ErrorResponse) SetError(val ErrorResponseError) {
+	s.Error = val
+}
+
+func (*ErrorResponse) getGroupsRes() {}
+
+// Response data (null in case of an error).
+type ErrorResponseContent struct{}
+
+type ErrorResponseError struct {
+	// Error code.
+	Code string `json:"code"`
+	// Error description.
+	Description string `json:"description"`
+}
+
+// GetCode returns the value of Code.
+func (s *ErrorResponseError) GetCode() string {
+	return s.Code
+}
+
+// GetDescription returns the value of Description.
+func (s *ErrorResponseError) GetDescription() string {
+	return s.Description
+}
+
+// SetCode sets the value of Code.
+func (s *ErrorResponseError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetDescription sets the value of Description.
+func (s *ErrorResponseError) SetDescription(val string) {
+	s.Description = val
+}
+
+type EvaluateExpressionBadRequest ErrorResponse
+
+func (*EvaluateExpressionBadRequest) evaluateExpressionRes() {}
+
+type EvaluateExpressionInternalServerError ErrorResponse
+
+func (*EvaluateExpressionInternalServerError) evaluateExpressionRes() {}
+
+type EvaluateExpressionOKTextPlain struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s EvaluateExpressionOKTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*EvaluateExpressionOKTextPlain) evaluateExpressionRes() {}
+
+type ExplainIpBadRequest ErrorResponse
+
+func (*ExplainIpBadRequest) explainIpRes() {}
+
+type ExplainIpForbidden ErrorResponse
+
+func (*ExplainIpForbidden) explainIpRes() {}
+
+type ExplainIpInternalServerError ErrorResponse
+
+func (*ExplainIpInternalServerError) explainIpRes() {}
+
+type ExplainIpNotFound ErrorResponse
+
+func (*ExplainIpNotFound) explainIpRes() {}
+
+// Формат ответа: json, text (CIDR построчно), nginx (блок geo),
+// ipset (скрипт для ipset restore), nftables (define на каждое семейство).
+// Ref: #/components/schemas/ExportFormat
+type ExportFormat string
+
+const (
+	ExportFormatJSON     ExportFormat = "json"
+	ExportFormatText     ExportFormat = "text"
+	ExportFormatNginx    ExportFormat = "nginx"
+	ExportFormatIpset    ExportFormat = "ipset"
+	ExportFormatNftables ExportFormat = "nftables"
+)
+
+// AllValues returns all ExportFormat values.
+func (ExportFormat) AllValues() []ExportFormat {
+	return []ExportFormat{
+		ExportFormatJSON,
+		ExportFormatText,
+		ExportFormatNginx,
+		ExportFormatIpset,
+		ExportFormatNftables,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ExportFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ExportFormatJSON:
+		return []byte(s), nil
+	case ExportFormatText:
+		return []byte(s), nil
+	case ExportFormatNginx:
+		return []byte(s), nil
+	case ExportFormatIpset:
+		return []byte(s), nil
+	case ExportFormatNftables:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ExportFormat) UnmarshalText(data []byte) error {
+	switch ExportFormat(data) {
+	case ExportFormatJSON:
+		*s = ExportFormatJSON
+		return nil
+	case ExportFormatText:
+		*s = ExportFormatText
+		return nil
+	case ExportFormatNginx:
+		*s = ExportFormatNginx
+		return nil
+	case ExportFormatIpset:
+		*s = ExportFormatIpset
+		return nil
+	case ExportFormatNftables:
+		*s = ExportFormatNftables
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ExpressionResult
+type ExpressionResult struct {
+	// Выражение в каноническом виде.
+	Expression string `json:"expression"`
+	// Минимальный список CIDR, сначала IPv4.
+	Networks      []Cidr `json:"networks"`
+	TotalNetworks int64  `json:"totalNetworks"`
+}
+
+// GetExpression returns the value of Expression.
+func (s *ExpressionResult) GetExpression() string {
+	return s.Expression
+}
+
+// GetNetworks returns the value of Networks.
+func (s *ExpressionResult) GetNetworks() []Cidr {
+	return s.Networks
+}
+
+// GetTotalNetworks returns the value of TotalNetworks.
+func (s *ExpressionResult) GetTotalNetworks() int64 {
+	return s.TotalNetworks
+}
+
+// SetExpression sets the value of Expression.
+func (s *ExpressionResult) SetExpression(val string) {
+	s.Expression = val
+}
+
+// SetNetworks sets the value of Networks.
+func (s *ExpressionResult) SetNetworks(val []Cidr) {
+	s.Networks = val
+}
+
+// SetTotalNetworks sets the value of TotalNetworks.
+func (s *ExpressionResult) SetTotalNetworks(val int64) {
+	s.TotalNetworks = val
+}
+
+func (*ExpressionResult) evaluateExpressionRes() {}
+
+// Ref: #/components/schemas/GeoIpData
+type GeoIpData struct {
+	// Адрес в том виде, в каком он передан в запросе.
+	IP string `json:"ip"`
+	// Адрес после разбора ("1.2.3.4:5678" -> "1.2.3.4", "[2001:db8::1]:443" ->
+	// "2001:db8::1").
+	NormalizedIp IpAddress `json:"normalizedIp"`
+	Code         IsoCode   `json:"code"`
+	// Optional (если появится источник имени страны).
+	CountryName OptNilString `json:"countryName"`
+	// Сеть из базы, в которую попал адрес (отсутствует, если
+	// адрес не найден).
+	Network OptCidr    `json:"network"`
+	Source  CodeSource `json:"source"`
+	// Registered_country из записи базы (если есть).
+	RegisteredCode OptIsoCode `json:"registeredCode"`
+	// Registered_country присутствует и отличается от code.
+	RegisteredCountryDiffers bool            `json:"registeredCountryDiffers"`
+	Provenance               OptProvenance   `json:"provenance"`
+	AddressClass             AddressClass    `json:"addressClass"`
+	Special                  OptSpecialBlock `json:"special"`
+	// Адрес не является глобально достижимым (по реестрам
+	// IANA).
+	Bogon bool `json:"bogon"`
+	// Адрес IPv6 содержит IPv4 адрес и определялся по нему
+	// (отсутствует, если нет).
+	Alias OptGeoIpDataAlias `json:"alias"`
+	// IPv4 адрес, извлечённый из ip и использованный для
+	// определения страны (вместе с alias).
+	LookupIp OptIpAddress `json:"lookupIp"`
+}
+
+// GetIP returns the value of IP.
+func (s *GeoIpData) GetIP() string {
+	return s.IP
+}
+
+// GetNormalizedIp returns the value of NormalizedIp.
+func (s *GeoIpData) GetNormalizedIp() IpAddress {
+	return s.NormalizedIp
+}
+
+// GetCode returns the value of Code.
+func (s *GeoIpData) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetCountryName returns the value of CountryName.
+func (s *GeoIpData) GetCountryName() OptNilString {
+	return s.CountryName
+}
+
+// GetNetwork returns the value of Network.
+func (s *GeoIpData) GetNetwork() OptCidr {
+	return s.Network
+}
+
+// GetSource returns the value of Source.
+func (s *GeoIpData) GetSource() CodeSource {
+	return s.Source
+}
+
+// GetRegisteredCode returns the value of RegisteredCode.
+func (s *GeoIpData) GetRegisteredCode() OptIsoCode {
+	return s.RegisteredCode
+}
+
+// GetRegisteredCountryDiffers returns the value of RegisteredCountryDiffers.
+func (s *GeoIpData) GetRegisteredCountryDiffers() bool {
+	return s.RegisteredCountryDiffers
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *GeoIpData) GetProvenance() OptProvenance {
+	return s.Provenance
+}
+
+// GetAddressClass returns the value of AddressClass.
+func (s *GeoIpData) GetAddressClass() AddressClass {
+	return s.AddressClass
+}
+
+// GetSpecial returns the value of Special.
+func (s *GeoIpData) GetSpecial() OptSpecialBlock {
+	return s.Special
+}
+
+// GetBogon returns the value of Bogon.
+func (s *GeoIpData) GetBogon() bool {
+	return s.Bogon
+}
+
+// GetAlias returns the value of Alias.
+func (s *GeoIpData) GetAlias() OptGeoIpDataAlias {
+	return s.Alias
+}
+
+// GetLookupIp returns the value of LookupIp.
+func (s *GeoIpData) GetLookupIp() OptIpAddress {
+	return s.LookupIp
+}
+
+// SetIP sets the value of IP.
+func (s *GeoIpData) SetIP(val string) {
+	s.IP = val
+}
+
+// SetNormalizedIp sets the value of NormalizedIp.
+func (s *GeoIpData) SetNormalizedIp(val IpAddress) {
+	s.NormalizedIp = val
+}
+
+// SetCode sets the value of Code.
+func (s *GeoIpData) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetCountryName sets the value of CountryName.
+func (s *GeoIpData) SetCountryName(val OptNilString) {
+	s.CountryName = val
+}
+
+// SetNetwork sets the value of Network.
+func (s *GeoIpData) SetNetwork(val OptCidr) {
+	s.Network = val
+}
+
+// SetSource sets the value of Source.
+func (s *GeoIpData) SetSource(val CodeSource) {
+	s.Source = val
+}
+
+// SetRegisteredCode sets the value of RegisteredCode.
+func (s *GeoIpData) SetRegisteredCode(val OptIsoCode) {
+	s.RegisteredCode = val
+}
+
+// SetRegisteredCountryDiffers sets the value of RegisteredCountryDiffers.
+func (s *GeoIpData) SetRegisteredCountryDiffers(val bool) {
+	s.RegisteredCountryDiffers = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *GeoIpData) SetProvenance(val OptProvenance) {
+	s.Provenance = val
+}
+
+// SetAddressClass sets the value of AddressClass.
+func (s *GeoIpData) SetAddressClass(val AddressClass) {
+	s.AddressClass = val
+}
+
+// SetSpecial sets the value of Special.
+func (s *GeoIpData) SetSpecial(val OptSpecialBlock) {
+	s.Special = val
+}
+
+// SetBogon sets the value of Bogon.
+func (s *GeoIpData) SetBogon(val bool) {
+	s.Bogon = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *GeoIpData) SetAlias(val OptGeoIpDataAlias) {
+	s.Alias = val
+}
+
+// SetLookupIp sets the value of LookupIp.
+func (s *GeoIpData) SetLookupIp(val OptIpAddress) {
+	s.LookupIp = val
+}
+
+// Адрес IPv6 содержит IPv4 адрес и определялся по нему
+// (отсутствует, если нет).
+type GeoIpDataAlias string
+
+const (
+	GeoIpDataAliasIpv4Mapped GeoIpDataAlias = "ipv4_mapped"
+	GeoIpDataAlias6to4       GeoIpDataAlias = "6to4"
+	GeoIpDataAliasTeredo     GeoIpDataAlias = "teredo"
+)
+
+// AllValues returns all GeoIpDataAlias values.
+func (GeoIpDataAlias) AllValues() []GeoIpDataAlias {
+	return []GeoIpDataAlias{
+		GeoIpDataAliasIpv4Mapped,
+		GeoIpDataAlias6to4,
+		GeoIpDataAliasTeredo,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GeoIpDataAlias) MarshalText() ([]byte, error) {
+	switch s {
+	case GeoIpDataAliasIpv4Mapped:
+		return []byte(s), nil
+	case GeoIpDataAlias6to4:
+		return []byte(s), nil
+	case GeoIpDataAliasTeredo:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GeoIpDataAlias) UnmarshalText(data []byte) error {
+	switch GeoIpDataAlias(data) {
+	case GeoIpDataAliasIpv4Mapped:
+		*s = GeoIpDataAliasIpv4Mapped
+		return nil
+	case GeoIpDataAlias6to4:
+		*s = GeoIpDataAlias6to4
+		return nil
+	case GeoIpDataAliasTeredo:
+		*s = GeoIpDataAliasTeredo
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GeoPayload
+type GeoPayload struct {
+	Ips []IpPayload `json:"ips"`
+	// Вернуть слой набора данных, ответивший на каждый
+	// адрес.
+	Provenance OptBool  `json:"provenance"`
+	Basis      OptBasis `json:"basis"`
+}
+
+// GetIps returns the value of Ips.
+func (s *GeoPayload) GetIps() []IpPayload {
+	return s.Ips
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *GeoPayload) GetProvenance() OptBool {
+	return s.Provenance
+}
+
+// GetBasis returns the value of Basis.
+func (s *GeoPayload) GetBasis() OptBasis {
+	return s.Basis
+}
+
+// SetIps sets the value of Ips.
+func (s *GeoPayload) SetIps(val []IpPayload) {
+	s.Ips = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *GeoPayload) SetProvenance(val OptBool) {
+	s.Provenance = val
+}
+
+// SetBasis sets the value of Basis.
+func (s *GeoPayload) SetBasis(val OptBasis) {
+	s.Basis = val
+}
+
+type GetBogonsBadRequest ErrorResponse
+
+func (*GetBogonsBadRequest) getBogonsRes() {}
+
+type GetBogonsInternalServerError ErrorResponse
+
+func (*GetBogonsInternalServerError) getBogonsRes() {}
+
+type GetBogonsOKTextPlain struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetBogonsOKTextPlain) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetBogonsOKTextPlain) getBogonsRes() {}
+
+type GetCidrDataBadRequest ErrorResponse
+
+func (*GetCidrDataBadRequest) getCidrDataRes() {}
+
+type GetCidrDataInternalServerError ErrorResponse
+
+func (*GetCidrDataInternalServerError) getCidrDataRes() {}
+
+type GetCidrDataOKApplicationJSON []CidrData
+
+func (*GetCidrDataOKApplicationJSON) getCidrDataRes() {}
+
+type GetCountriesBadRequest ErrorResponse
+
+func (*GetCountriesBadRequest) getCountriesRes() {}
+
+type GetCountriesInternalServerError ErrorResponse
+
+func (*GetCountriesInternalServerError) getCountriesRes() {}
+
+type GetCountriesNotFound ErrorResponse
+
+func (*GetCountriesNotFound) getCountriesRes() {}
+
+type GetCountriesOKApplicationJSON []CountryRangeData
+
+func (*GetCountriesOKApplicationJSON) getCountriesRes() {}
+
+type GetCountryBadRequest ErrorResponse
+
+func (*GetCountryBadRequest) getCountryRes() {}
+
+type GetCountryInternalServerError ErrorResponse
+
+func (*GetCountryInternalServerError) getCountryRes() {}
+
+type GetCountryNetworksBadRequest ErrorResponse
+
+func (*GetCountryNetworksBadRequest) getCountryNetworksRes() {}
+
+type GetCountryNetworksInternalServerError ErrorResponse
+
+func (*GetCountryNetworksInternalServerError) getCountryNetworksRes() {}
+
+type GetCountryNetworksNotFound ErrorResponse
+
+func (*GetCountryNetworksNotFound) getCountryNetworksRes() {}
+
+type GetCountryNetworksOKApplicationJSON []IsoCodeNetworks
+
+func (*GetCountryNetworksOKApplicationJSON) getCountryNetworksRes() {}
+
+type GetCountryNetworksPagedBadRequest ErrorResponse
+
+func (*GetCountryNetworksPagedBadRequest) getCountryNetworksPagedRes() {}
+
+type GetCountryNetworksPagedInternalServerError ErrorResponse
+
+func (*GetCountryNetworksPagedInternalServerError) getCountryNetworksPagedRes() {}
+
+type GetCountryNetworksPagedNotFound ErrorResponse
+
+func (*GetCountryNetworksPagedNotFound) getCountryNetworksPagedRes() {}
+
+type GetCountryNotFound ErrorResponse
+
+func (*GetCountryNotFound) getCountryRes() {}
+
+type GetDatasetBadRequest ErrorResponse
+
+func (*GetDatasetBadRequest) getDatasetRes() {}
+
+type GetDatasetInternalServerError ErrorResponse
+
+func (*GetDatasetInternalServerError) getDatasetRes() {}
+
+type GetGroupsOKApplicationJSON []CountryGroup
+
+func (*GetGroupsOKApplicationJSON) getGroupsRes() {}
+
+type GetIpDataBadRequest ErrorResponse
+
+func (*GetIpDataBadRequest) getIpDataRes() {}
+
+type GetIpDataInternalServerError ErrorResponse
+
+func (*GetIpDataInternalServerError) getIpDataRes() {}
+
+type GetIpDataNotFound ErrorResponse
+
+func (*GetIpDataNotFound) getIpDataRes() {}
+
+type GetIpDataOKApplicationJSON []GeoIpData
+
+func (*GetIpDataOKApplicationJSON) getIpDataRes() {}
+
+type GetQualityReportBadRequest ErrorResponse
+
+func (*GetQualityReportBadRequest) getQualityReportRes() {}
+
+type GetQualityReportInternalServerError ErrorResponse
+
+func (*GetQualityReportInternalServerError) getQualityReportRes() {}
+
+type GetRangeBreakdownBadRequest ErrorResponse
+
+func (*GetRangeBreakdownBadRequest) getRangeBreakdownRes() {}
+
+type GetRangeBreakdownInternalServerError ErrorResponse
+
+func (*GetRangeBreakdownInternalServerError) getRangeBreakdownRes() {}
+
+type GetRangeBreakdownOKApplicationJSON []RangeBreakdown
+
+func (*GetRangeBreakdownOKApplicationJSON) getRangeBreakdownRes() {}
+
+// Service health status.
+// Ref: #/components/schemas/Health
+type Health struct {
+	// Service uptime (seconds).
+	Uptime int `json:"uptime"`
+	// Service version.
+	Version string `json:"version"`
+}
+
+// GetUptime returns the value of Uptime.
+func (s *Health) GetUptime() int {
+	return s.Uptime
+}
+
+// GetVersion returns the value of Version.
+func (s *Health) GetVersion() string {
+	return s.Version
+}
+
+// SetUptime sets the value of Uptime.
+func (s *Health) SetUptime(val int) {
+	s.Uptime = val
+}
+
+// SetVersion sets the value of Version.
+func (s *Health) SetVersion(val string) {
+	s.Version = val
+}
+
+type IpAddress string
+
+// Ref: #/components/schemas/IpExplanation
+type IpExplanation struct {
+	IP            IpAddress `json:"ip"`
+	Address       IpAddress `json:"address"`
+	Alias         AliasInfo `json:"alias"`
+	LookupAddress IpAddress `json:"lookupAddress"`
+	// Адрес найден в базе.
+	Found   bool    `json:"found"`
+	Network OptCidr `json:"network"`
+	// Исходная запись MMDB без обработки.
+	Record          OptIpExplanationRecord `json:"record"`
+	DatabaseNetwork OptCidr                `json:"databaseNetwork"`
+	DatabaseCode    IsoCode                `json:"databaseCode"`
+	Override        OptOverrideInfo        `json:"override"`
+	Normalization   []NormalizeStep        `json:"normalization"`
+	Code            IsoCode                `json:"code"`
+	Source          CodeSource             `json:"source"`
+	Dataset         DatasetInfo            `json:"dataset"`
+}
+
+// GetIP returns the value of IP.
+func (s *IpExplanation) GetIP() IpAddress {
+	return s.IP
+}
+
+// GetAddress returns the value of Address.
+func (s *IpExplanation) GetAddress() IpAddress {
+	return s.Address
+}
+
+// GetAlias returns the value of Alias.
+func (s *IpExplanation) GetAlias() AliasInfo {
+	return s.Alias
+}
+
+// GetLookupAddress returns the value of LookupAddress.
+func (s *IpExplanation) GetLookupAddress() IpAddress {
+	return s.LookupAddress
+}
+
+// GetFound returns the value of Found.
+func (s *IpExplanation) GetFound() bool {
+	return s.Found
+}
+
+// GetNetwork returns the value of Network.
+func (s *IpExplanation) GetNetwork() OptCidr {
+	return s.Network
+}
+
+// GetRecord returns the value of Record.
+func (s *IpExplanation) GetRecord() OptIpExplanationRecord {
+	return s.Record
+}
+
+// GetDatabaseNetwork returns the value of DatabaseNetwork.
+func (s *IpExplanation) GetDatabaseNetwork() OptCidr {
+	return s.DatabaseNetwork
+}
+
+// GetDatabaseCode returns the value of DatabaseCode.
+func (s *IpExplanation) GetDatabaseCode() IsoCode {
+	return s.DatabaseCode
+}
+
+// GetOverride returns the value of Override.
+func (s *IpExplanation) GetOverride() OptOverrideInfo {
+	return s.Override
+}
+
+// GetNormalization returns the value of Normalization.
+func (s *IpExplanation) GetNormalization() []NormalizeStep {
+	return s.Normalization
+}
+
+// GetCode returns the value of Code.
+func (s *IpExplanation) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetSource returns the value of Source.
+func (s *IpExplanation) GetSource() CodeSource {
+	return s.Source
+}
+
+// GetDataset returns the value of Dataset.
+func (s *IpExplanation) GetDataset() DatasetInfo {
+	return s.Dataset
+}
+
+// SetIP sets the value of IP.
+func (s *IpExplanation) SetIP(val IpAddress) {
+	s.IP = val
+}
+
+// SetAddress sets the value of Address.
+func (s *IpExplanation) SetAddress(val IpAddress) {
+	s.Address = val
+}
+
+// SetAlias sets the value of Alias.
+func (s *IpExplanation) SetAlias(val AliasInfo) {
+	s.Alias = val
+}
+
+// SetLookupAddress sets the value of LookupAddress.
+func (s *IpExplanation) SetLookupAddress(val IpAddress) {
+	s.LookupAddress = val
+}
+
+// SetFound sets the value of Found.
+func (s *IpExplanation) SetFound(val bool) {
+	s.Found = val
+}
+
+// SetNetwork sets the value of Network.
+func (s *IpExplanation) SetNetwork(val OptCidr) {
+	s.Network = val
+}
+
+// SetRecord sets the value of Record.
+func (s *IpExplanation) SetRecord(val OptIpExplanationRecord) {
+	s.Record = val
+}
+
+// SetDatabaseNetwork sets the value of DatabaseNetwork.
+func (s *IpExplanation) SetDatabaseNetwork(val OptCidr) {
+	s.DatabaseNetwork = val
+}
+
+// SetDatabaseCode sets the value of DatabaseCode.
+func (s *IpExplanation) SetDatabaseCode(val IsoCode) {
+	s.DatabaseCode = val
+}
+
+// SetOverride sets the value of Override.
+func (s *IpExplanation) SetOverride(val OptOverrideInfo) {
+	s.Override = val
+}
+
+// SetNormalization sets the value of Normalization.
+func (s *IpExplanation) SetNormalization(val []NormalizeStep) {
+	s.Normalization = val
+}
+
+// SetCode sets the value of Code.
+func (s *IpExplanation) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetSource sets the value of Source.
+func (s *IpExplanation) SetSource(val CodeSource) {
+	s.Source = val
+}
+
+// SetDataset sets the value of Dataset.
+func (s *IpExplanation) SetDataset(val DatasetInfo) {
+	s.Dataset = val
+}
+
+func (*IpExplanation) explainIpRes() {}
+
+// Исходная запись MMDB без обработки.
+type IpExplanationRecord map[string]jx.Raw
+
+func (s *IpExplanationRecord) init() IpExplanationRecord {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/IpPayload
+type IpPayload struct {
+	// IPv4 или IPv6 адрес. В зависимости от настройки ip_input также
+	// принимаются адрес с портом
+	// ("1.2.3.4:5678", "[2001:db8::1]:443"), адрес в скобках, адрес с зоной
+	// ("fe80::1%eth0"),
+	// десятичное число IPv4 ("16909060") и CIDR (определяется по
+	// адресу сети).
+	IP string `json:"ip"`
+}
+
+// GetIP returns the value of IP.
+func (s *IpPayload) GetIP() string {
+	return s.IP
+}
+
+// SetIP sets the value of IP.
+func (s *IpPayload) SetIP(val string) {
+	s.IP = val
+}
+
+type IsoCode string
+
+// Ref: #/components/schemas/IsoCodeNetworks
+type IsoCodeNetworks struct {
+	Code     IsoCode `json:"code"`
+	Networks []Cidr  `json:"networks"`
+	// Источник каждой подсети (в том же порядке, что networks).
+	Provenance []Provenance `json:"provenance"`
+}
+
+// GetCode returns the value of Code.
+func (s *IsoCodeNetworks) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetNetworks returns the value of Networks.
+func (s *IsoCodeNetworks) GetNetworks() []Cidr {
+	return s.Networks
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *IsoCodeNetworks) GetProvenance() []Provenance {
+	return s.Provenance
+}
+
+// SetCode sets the value of Code.
+func (s *IsoCodeNetworks) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetNetworks sets the value of Networks.
+func (s *IsoCodeNetworks) SetNetworks(val []Cidr) {
+	s.Networks = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *IsoCodeNetworks) SetProvenance(val []Provenance) {
+	s.Provenance = val
+}
+
+// Ref: #/components/schemas/NetworkData
+type NetworkData struct {
+	Network    Cidr       `json:"network"`
+	Code       IsoCode    `json:"code"`
+	Source     CodeSource `json:"source"`
+	Provenance Provenance `json:"provenance"`
+}
+
+// GetNetwork returns the value of Network.
+func (s *NetworkData) GetNetwork() Cidr {
+	return s.Network
+}
+
+// GetCode returns the value of Code.
+func (s *NetworkData) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetSource returns the value of Source.
+func (s *NetworkData) GetSource() CodeSource {
+	return s.Source
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *NetworkData) GetProvenance() Provenance {
+	return s.Provenance
+}
+
+// SetNetwork sets the value of Network.
+func (s *NetworkData) SetNetwork(val Cidr) {
+	s.Network = val
+}
+
+// SetCode sets the value of Code.
+func (s *NetworkData) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetSource sets the value of Source.
+func (s *NetworkData) SetSource(val CodeSource) {
+	s.Source = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *NetworkData) SetProvenance(val Provenance) {
+	s.Provenance = val
+}
+
+// Ref: #/components/schemas/NetworkIssue
+type NetworkIssue struct {
+	Network        Cidr       `json:"network"`
+	Code           IsoCode    `json:"code"`
+	RegisteredCode OptIsoCode `json:"registeredCode"`
+	Provenance     Provenance `json:"provenance"`
+}
+
+// GetNetwork returns the value of Network.
+func (s *NetworkIssue) GetNetwork() Cidr {
+	return s.Network
+}
+
+// GetCode returns the value of Code.
+func (s *NetworkIssue) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetRegisteredCode returns the value of RegisteredCode.
+func (s *NetworkIssue) GetRegisteredCode() OptIsoCode {
+	return s.RegisteredCode
+}
+
+// GetProvenance returns the value of Provenance.
+func (s *NetworkIssue) GetProvenance() Provenance {
+	return s.Provenance
+}
+
+// SetNetwork sets the value of Network.
+func (s *NetworkIssue) SetNetwork(val Cidr) {
+	s.Network = val
+}
+
+// SetCode sets the value of Code.
+func (s *NetworkIssue) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetRegisteredCode sets the value of RegisteredCode.
+func (s *NetworkIssue) SetRegisteredCode(val OptIsoCode) {
+	s.RegisteredCode = val
+}
+
+// SetProvenance sets the value of Provenance.
+func (s *NetworkIssue) SetProvenance(val Provenance) {
+	s.Provenance = val
+}
+
+// Ref: #/components/schemas/NormalizeStep
+type NormalizeStep struct {
+	Field      string `json:"field"`
+	Raw        string `json:"raw"`
+	Normalized string `json:"normalized"`
+	Selected   bool   `json:"selected"`
+}
+
+// GetField returns the value of Field.
+func (s *NormalizeStep) GetField() string {
+	return s.Field
+}
+
+// GetRaw returns the value of Raw.
+func (s *NormalizeStep) GetRaw() string {
+	return s.Raw
+}
+
+// GetNormalized returns the value of Normalized.
+func (s *NormalizeStep) GetNormalized() string {
+	return s.Normalized
+}
+
+// GetSelected returns the value of Selected.
+func (s *NormalizeStep) GetSelected() bool {
+	return s.Selected
+}
+
+// SetField sets the value of Field.
+func (s *NormalizeStep) SetField(val string) {
+	s.Field = val
+}
+
+// SetRaw sets the value of Raw.
+func (s *NormalizeStep) SetRaw(val string) {
+	s.Raw = val
+}
+
+// SetNormalized sets the value of Normalized.
+func (s *NormalizeStep) SetNormalized(val string) {
+	s.Normalized = val
+}
+
+// SetSelected sets the value of Selected.
+func (s *NormalizeStep) SetSelected(val bool) {
+	s.Selected = val
+}
+
+// NewOptBasis returns new OptBasis with value set to v.
+func NewOptBasis(v Basis) OptBasis {
+	return OptBasis{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBasis is optional Basis.
+type OptBasis struct {
+	Value Basis
+	Set   bool
+}
+
+// IsSet returns true if OptBasis was set.
+func (o OptBasis) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBasis) Reset() {
+	var v Basis
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBasis) SetTo(v Basis) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBasis) Get() (v Basis, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBasis) Or(d Basis) Basis {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCidr returns new OptCidr with value set to v.
+func NewOptCidr(v Cidr) OptCidr {
+	return OptCidr{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCidr is optional Cidr.
+type OptCidr struct {
+	Value Cidr
+	Set   bool
+}
+
+// IsSet returns true if OptCidr was set.
+func (o OptCidr) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCidr) Reset() {
+	var v Cidr
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCidr) SetTo(v Cidr) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCidr) Get() (v Cidr, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCidr) Or(d Cidr) Cidr {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptErrorResponseContent returns new OptErrorResponseContent with value set to v.
+func NewOptErrorResponseContent(v *ErrorResponseContent) OptErrorResponseContent {
+	return OptErrorResponseContent{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptErrorResponseContent is optional *ErrorResponseContent.
+type OptErrorResponseContent struct {
+	Value *ErrorResponseContent
+	Set   bool
+}
+
+// IsSet returns true if OptErrorResponseContent was set.
+func (o OptErrorResponseContent) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptErrorResponseContent) Reset() {
+	var v *ErrorResponseContent
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptErrorResponseContent) SetTo(v *ErrorResponseContent) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorResponseContent) Get() (v *ErrorResponseContent, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorResponseContent) Or(d *ErrorResponseContent) *ErrorResponseContent {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptExportFormat returns new OptExportFormat with value set to v.
+func NewOptExportFormat(v ExportFormat) OptExportFormat {
+	return OptExportFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptExportFormat is optional ExportFormat.
+type OptExportFormat struct {
+	Value ExportFormat
+	Set   bool
+}
+
+// IsSet returns true if OptExportFormat was set.
+func (o OptExportFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptExportFormat) Reset() {
+	var v ExportFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptExportFormat) SetTo(v ExportFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptExportFormat) Get() (v ExportFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptExportFormat) Or(d ExportFormat) ExportFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGeoIpDataAlias returns new OptGeoIpDataAlias with value set to v.
+func NewOptGeoIpDataAlias(v GeoIpDataAlias) OptGeoIpDataAlias {
+	return OptGeoIpDataAlias{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGeoIpDataAlias is optional GeoIpDataAlias.
+type OptGeoIpDataAlias struct {
+	Value GeoIpDataAlias
+	Set   bool
+}
+
+// IsSet returns true if OptGeoIpDataAlias was set.
+func (o OptGeoIpDataAlias) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGeoIpDataAlias) Reset() {
+	var v GeoIpDataAlias
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGeoIpDataAlias) SetTo(v GeoIpDataAlias) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGeoIpDataAlias) Get() (v GeoIpDataAlias, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGeoIpDataAlias) Or(d GeoIpDataAlias) GeoIpDataAlias {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIpAddress returns new OptIpAddress with value set to v.
+func NewOptIpAddress(v IpAddress) OptIpAddress {
+	return OptIpAddress{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIpAddress is optional IpAddress.
+type OptIpAddress struct {
+	Value IpAddress
+	Set   bool
+}
+
+// IsSet returns true if OptIpAddress was set.
+func (o OptIpAddress) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIpAddress) Reset() {
+	var v IpAddress
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIpAddress) SetTo(v IpAddress) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIpAddress) Get() (v IpAddress, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIpAddress) Or(d IpAddress) IpAddress {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIpExplanationRecord returns new OptIpExplanationRecord with value set to v.
+func NewOptIpExplanationRecord(v IpExplanationRecord) OptIpExplanationRecord {
+	return OptIpExplanationRecord{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIpExplanationRecord is optional IpExplanationRecord.
+type OptIpExplanationRecord struct {
+	Value IpExplanationRecord
+	Set   bool
+}
+
+// IsSet returns true if OptIpExplanationRecord was set.
+func (o OptIpExplanationRecord) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIpExplanationRecord) Reset() {
+	var v IpExplanationRecord
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIpExplanationRecord) SetTo(v IpExplanationRecord) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIpExplanationRecord) Get() (v IpExplanationRecord, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIpExplanationRecord) Or(d IpExplanationRecord) IpExplanationRecord {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptIsoCode returns new OptIsoCode with value set to v.
+func NewOptIsoCode(v IsoCode) OptIsoCode {
+	return OptIsoCode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptIsoCode is optional IsoCode.
+type OptIsoCode struct {
+	Value IsoCode
+	Set   bool
+}
+
+// IsSet returns true if OptIsoCode was set.
+func (o OptIsoCode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptIsoCode) Reset() {
+	var v IsoCode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptIsoCode) SetTo(v IsoCode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptIsoCode) Get() (v IsoCode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptIsoCode) Or(d IsoCode) IsoCode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNetworkData returns new OptNetworkData with value set to v.
+func NewOptNetworkData(v NetworkData) OptNetworkData {
+	return OptNetworkData{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNetworkData is optional NetworkData.
+type OptNetworkData struct {
+	Value NetworkData
+	Set   bool
+}
+
+// IsSet returns true if OptNetworkData was set.
+func (o OptNetworkData) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNetworkData) Reset() {
+	var v NetworkData
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptNetworkData) SetTo(v NetworkData) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNetworkData) Get() (v NetworkData, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNetworkData) Or(d NetworkData) NetworkData {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOverrideInfo returns new OptOverrideInfo with value set to v.
+func NewOptOverrideInfo(v OverrideInfo) OptOverrideInfo {
+	return OptOverrideInfo{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOverrideInfo is optional OverrideInfo.
+type OptOverrideInfo struct {
+	Value OverrideInfo
+	Set   bool
+}
+
+// IsSet returns true if OptOverrideInfo was set.
+func (o OptOverrideInfo) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOverrideInfo) Reset() {
+	var v OverrideInfo
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOverrideInfo) SetTo(v OverrideInfo) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOverrideInfo) Get() (v OverrideInfo, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOverrideInfo) Or(d OverrideInfo) OverrideInfo {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptProvenance returns new OptProvenance with value set to v.
+func NewOptProvenance(v Provenance) OptProvenance {
+	return OptProvenance{
+		Value: v,
+		Set:   true,
+	}
 }
 
-type IsoCode string
-
-// Ref: #/components/schemas/IsoCodeNetworks
-type IsoCodeNetworks struct {
-	Code     IsoCode `json:"code"`
-	Networks []Cidr  `json:"networks"`
+// OptProvenance is optional Provenance.
+type OptProvenance struct {
+	Value Provenance
+	Set   bool
 }
 
-// GetCode returns the value of Code.
-func (s *IsoCodeNetworks) GetCode() IsoCode {
-	return s.Code
+// IsSet returns true if OptProvenance was set.
+func (o OptProvenance) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptProvenance) Reset() {
+	var v Provenance
+	o.Value = v
+	o.Set = false
 }
 
-// GetNetworks returns the value of Networks.
-func (s *IsoCodeNetworks) GetNetworks() []Cidr {
-	return s.Networks
+// SetTo sets value to v.
+func (o *OptProvenance) SetTo(v Provenance) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetCode sets the value of Code.
-func (s *IsoCodeNetworks) SetCode(val IsoCode) {
-	s.Code = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptProvenance) Get() (v Provenance, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetNetworks sets the value of Networks.
-func (s *IsoCodeNetworks) SetNetworks(val []Cidr) {
-	s.Networks = val
+// Or returns value if set, or given parameter if does not.
+func (o OptProvenance) Or(d Provenance) Provenance {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// NewOptErrorResponseContent returns new OptErrorResponseContent with value set to v.
-func NewOptErrorResponseContent(v *ErrorResponseContent) OptErrorResponseContent {
-	return OptErrorResponseContent{
+// NewOptSpecialBlock returns new OptSpecialBlock with value set to v.
+func NewOptSpecialBlock(v SpecialBlock) OptSpecialBlock {
+	return OptSpecialBlock{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptErrorResponseContent is optional *ErrorResponseContent.
-type OptErrorResponseContent struct {
-	Value *ErrorResponseContent
+// OptSpecialBlock is optional SpecialBlock.
+type OptSpecialBlock struct {
+	Value SpecialBlock
 	Set   bool
 }
 
-// IsSet returns true if OptErrorResponseContent was set.
-func (o OptErrorResponseContent) IsSet() bool { return o.Set }
+// IsSet returns true if OptSpecialBlock was set.
+func (o OptSpecialBlock) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptErrorResponseContent) Reset() {
-	var v *ErrorResponseContent
+func (o *OptSpecialBlock) Reset() {
+	var v SpecialBlock
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptErrorResponseContent) SetTo(v *ErrorResponseContent) {
+func (o *OptSpecialBlock) SetTo(v SpecialBlock) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptErrorResponseContent) Get() (v *ErrorResponseContent, ok bool) {
+func (o OptSpecialBlock) Get() (v SpecialBlock, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -359,62 +3144,45 @@ func (o OptErrorResponseContent) Get() (v *ErrorResponseContent, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptErrorResponseContent) Or(d *ErrorResponseContent) *ErrorResponseContent {
+func (o OptSpecialBlock) Or(d SpecialBlock) SpecialBlock {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
+// OptString is optional string.
+type OptString struct {
 	Value string
 	Set   bool
-	Null  bool
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptNilString) Reset() {
+func (o *OptString) Reset() {
 	var v string
 	o.Value = v
 	o.Set = false
-	o.Null = false
 }
 
 // SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
-
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
+func (o *OptString) SetTo(v string) {
 	o.Set = true
-	o.Null = true
-	var v string
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
+func (o OptString) Get() (v string, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -422,20 +3190,83 @@ func (o OptNilString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
+func (o OptString) Or(d string) string {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
+// Локальное переопределение, сработавшее для адреса.
+// Ref: #/components/schemas/OverrideInfo
+type OverrideInfo struct {
+	Network Cidr        `json:"network"`
+	Code    IsoCode     `json:"code"`
+	Comment OptString   `json:"comment"`
+	Expires OptDateTime `json:"expires"`
+	// Файл и строка, откуда прочитано переопределение.
+	Origin string `json:"origin"`
+}
+
+// GetNetwork returns the value of Network.
+func (s *OverrideInfo) GetNetwork() Cidr {
+	return s.Network
+}
+
+// GetCode returns the value of Code.
+func (s *OverrideInfo) GetCode() IsoCode {
+	return s.Code
+}
+
+// GetComment returns the value of Comment.
+func (s *OverrideInfo) GetComment() OptString {
+	return s.Comment
+}
+
+// GetExpires returns the value of Expires.
+func (s *OverrideInfo) GetExpires() OptDateTime {
+	return s.Expires
+}
+
+// GetOrigin returns the value of Origin.
+func (s *OverrideInfo) GetOrigin() string {
+	return s.Origin
+}
+
+// SetNetwork sets the value of Network.
+func (s *OverrideInfo) SetNetwork(val Cidr) {
+	s.Network = val
+}
+
+// SetCode sets the value of Code.
+func (s *OverrideInfo) SetCode(val IsoCode) {
+	s.Code = val
+}
+
+// SetComment sets the value of Comment.
+func (s *OverrideInfo) SetComment(val OptString) {
+	s.Comment = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *OverrideInfo) SetExpires(val OptDateTime) {
+	s.Expires = val
+}
+
+// SetOrigin sets the value of Origin.
+func (s *OverrideInfo) SetOrigin(val string) {
+	s.Origin = val
+}
+
 // Ref: #/components/schemas/PageDataString
 type PageDataString struct {
-	Content       []Cidr `json:"content"`
-	TotalElements int64  `json:"totalElements"`
-	TotalPages    int64  `json:"totalPages"`
-	Size          int64  `json:"size"`
-	Page          int64  `json:"page"`
+	Content []Cidr `json:"content"`
+	// Источник каждой подсети (в том же порядке, что content).
+	Provenance    []Provenance `json:"provenance"`
+	TotalElements int64        `json:"totalElements"`
+	TotalPages    int64        `json:"totalPages"`
+	Size          int64        `json:"size"`
+	Page          int64        `json:"page"`
 }
 
 // GetContent returns the value of Content.
@@ -443,6 +3274,11 @@ func (s *PageDataString) GetContent() []Cidr {
 	return s.Content
 }
 
+// GetProvenance returns the value of Provenance.
+func (s *PageDataString) GetProvenance() []Provenance {
+	return s.Provenance
+}
+
 // GetTotalElements returns the value of TotalElements.
 func (s *PageDataString) GetTotalElements() int64 {
 	return s.TotalElements
@@ -468,6 +3304,11 @@ func (s *PageDataString) SetContent(val []Cidr) {
 	s.Content = val
 }
 
+// SetProvenance sets the value of Provenance.
+func (s *PageDataString) SetProvenance(val []Provenance) {
+	s.Provenance = val
+}
+
 // SetTotalElements sets the value of TotalElements.
 func (s *PageDataString) SetTotalElements(val int64) {
 	s.TotalElements = val
@@ -489,3 +3330,407 @@ func (s *PageDataString) SetPage(val int64) {
 }
 
 func (*PageDataString) getCountryNetworksPagedRes() {}
+
+// Ref: #/components/schemas/PrefixLengthCount
+type PrefixLengthCount struct {
+	Bits     int32 `json:"bits"`
+	Networks int32 `json:"networks"`
+}
+
+// GetBits returns the value of Bits.
+func (s *PrefixLengthCount) GetBits() int32 {
+	return s.Bits
+}
+
+// GetNetworks returns the value of Networks.
+func (s *PrefixLengthCount) GetNetworks() int32 {
+	return s.Networks
+}
+
+// SetBits sets the value of Bits.
+func (s *PrefixLengthCount) SetBits(val int32) {
+	s.Bits = val
+}
+
+// SetNetworks sets the value of Networks.
+func (s *PrefixLengthCount) SetNetworks(val int32) {
+	s.Networks = val
+}
+
+// Слой набора данных, из которого получен ответ.
+// Ref: #/components/schemas/Provenance
+type Provenance string
+
+const (
+	ProvenanceMmdb      Provenance = "mmdb"
+	ProvenanceRir       Provenance = "rir"
+	ProvenanceCsv       Provenance = "csv"
+	ProvenanceOverrides Provenance = "overrides"
+)
+
+// AllValues returns all Provenance values.
+func (Provenance) AllValues() []Provenance {
+	return []Provenance{
+		ProvenanceMmdb,
+		ProvenanceRir,
+		ProvenanceCsv,
+		ProvenanceOverrides,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Provenance) MarshalText() ([]byte, error) {
+	switch s {
+	case ProvenanceMmdb:
+		return []byte(s), nil
+	case ProvenanceRir:
+		return []byte(s), nil
+	case ProvenanceCsv:
+		return []byte(s), nil
+	case ProvenanceOverrides:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Provenance) UnmarshalText(data []byte) error {
+	switch Provenance(data) {
+	case ProvenanceMmdb:
+		*s = ProvenanceMmdb
+		return nil
+	case ProvenanceRir:
+		*s = ProvenanceRir
+		return nil
+	case ProvenanceCsv:
+		*s = ProvenanceCsv
+		return nil
+	case ProvenanceOverrides:
+		*s = ProvenanceOverrides
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/QualityReport
+type QualityReport struct {
+	// Подсети, страна которых отличается от registered_country.
+	RegisteredDiffers      []NetworkIssue `json:"registeredDiffers"`
+	TotalRegisteredDiffers int64          `json:"totalRegisteredDiffers"`
+	// Подсети без страны в источнике, получившие код по
+	// умолчанию.
+	Fallbacks           []NetworkIssue  `json:"fallbacks"`
+	TotalFallbacks      int64           `json:"totalFallbacks"`
+	AliasConflicts      []AliasConflict `json:"aliasConflicts"`
+	TotalAliasConflicts int64           `json:"totalAliasConflicts"`
+	// Известна предыдущая сборка; без неё changes пуст.
+	HasPrevious bool `json:"hasPrevious"`
+	// Страны с резким изменением, по убыванию изменения.
+	Changes      []CountryChange `json:"changes"`
+	TotalChanges int64           `json:"totalChanges"`
+}
+
+// GetRegisteredDiffers returns the value of RegisteredDiffers.
+func (s *QualityReport) GetRegisteredDiffers() []NetworkIssue {
+	return s.RegisteredDiffers
+}
+
+// GetTotalRegisteredDiffers returns the value of TotalRegisteredDiffers.
+func (s *QualityReport) GetTotalRegisteredDiffers() int64 {
+	return s.TotalRegisteredDiffers
+}
+
+// GetFallbacks returns the value of Fallbacks.
+func (s *QualityReport) GetFallbacks() []NetworkIssue {
+	return s.Fallbacks
+}
+
+// GetTotalFallbacks returns the value of TotalFallbacks.
+func (s *QualityReport) GetTotalFallbacks() int64 {
+	return s.TotalFallbacks
+}
+
+// GetAliasConflicts returns the value of AliasConflicts.
+func (s *QualityReport) GetAliasConflicts() []AliasConflict {
+	return s.AliasConflicts
+}
+
+// GetTotalAliasConflicts returns the value of TotalAliasConflicts.
+func (s *QualityReport) GetTotalAliasConflicts() int64 {
+	return s.TotalAliasConflicts
+}
+
+// GetHasPrevious returns the value of HasPrevious.
+func (s *QualityReport) GetHasPrevious() bool {
+	return s.HasPrevious
+}
+
+// GetChanges returns the value of Changes.
+func (s *QualityReport) GetChanges() []CountryChange {
+	return s.Changes
+}
+
+// GetTotalChanges returns the value of TotalChanges.
+func (s *QualityReport) GetTotalChanges() int64 {
+	return s.TotalChanges
+}
+
+// SetRegisteredDiffers sets the value of RegisteredDiffers.
+func (s *QualityReport) SetRegisteredDiffers(val []NetworkIssue) {
+	s.RegisteredDiffers = val
+}
+
+// SetTotalRegisteredDiffers sets the value of TotalRegisteredDiffers.
+func (s *QualityReport) SetTotalRegisteredDiffers(val int64) {
+	s.TotalRegisteredDiffers = val
+}
+
+// SetFallbacks sets the value of Fallbacks.
+func (s *QualityReport) SetFallbacks(val []NetworkIssue) {
+	s.Fallbacks = val
+}
+
+// SetTotalFallbacks sets the value of TotalFallbacks.
+func (s *QualityReport) SetTotalFallbacks(val int64) {
+	s.TotalFallbacks = val
+}
+
+// SetAliasConflicts sets the value of AliasConflicts.
+func (s *QualityReport) SetAliasConflicts(val []AliasConflict) {
+	s.AliasConflicts = val
+}
+
+// SetTotalAliasConflicts sets the value of TotalAliasConflicts.
+func (s *QualityReport) SetTotalAliasConflicts(val int64) {
+	s.TotalAliasConflicts = val
+}
+
+// SetHasPrevious sets the value of HasPrevious.
+func (s *QualityReport) SetHasPrevious(val bool) {
+	s.HasPrevious = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *QualityReport) SetChanges(val []CountryChange) {
+	s.Changes = val
+}
+
+// SetTotalChanges sets the value of TotalChanges.
+func (s *QualityReport) SetTotalChanges(val int64) {
+	s.TotalChanges = val
+}
+
+func (*QualityReport) getQualityReportRes() {}
+
+// Ref: #/components/schemas/RangeBreakdown
+type RangeBreakdown struct {
+	// Диапазон в том виде, в котором он был передан.
+	Query     string       `json:"query"`
+	First     IpAddress    `json:"first"`
+	Last      IpAddress    `json:"last"`
+	Addresses AddressCount `json:"addresses"`
+	// Страны по убыванию количества адресов.
+	Countries   []CountryShare   `json:"countries"`
+	Unallocated UnallocatedShare `json:"unallocated"`
+}
+
+// GetQuery returns the value of Query.
+func (s *RangeBreakdown) GetQuery() string {
+	return s.Query
+}
+
+// GetFirst returns the value of First.
+func (s *RangeBreakdown) GetFirst() IpAddress {
+	return s.First
+}
+
+// GetLast returns the value of Last.
+func (s *RangeBreakdown) GetLast() IpAddress {
+	return s.Last
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *RangeBreakdown) GetAddresses() AddressCount {
+	return s.Addresses
+}
+
+// GetCountries returns the value of Countries.
+func (s *RangeBreakdown) GetCountries() []CountryShare {
+	return s.Countries
+}
+
+// GetUnallocated returns the value of Unallocated.
+func (s *RangeBreakdown) GetUnallocated() UnallocatedShare {
+	return s.Unallocated
+}
+
+// SetQuery sets the value of Query.
+func (s *RangeBreakdown) SetQuery(val string) {
+	s.Query = val
+}
+
+// SetFirst sets the value of First.
+func (s *RangeBreakdown) SetFirst(val IpAddress) {
+	s.First = val
+}
+
+// SetLast sets the value of Last.
+func (s *RangeBreakdown) SetLast(val IpAddress) {
+	s.Last = val
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *RangeBreakdown) SetAddresses(val AddressCount) {
+	s.Addresses = val
+}
+
+// SetCountries sets the value of Countries.
+func (s *RangeBreakdown) SetCountries(val []CountryShare) {
+	s.Countries = val
+}
+
+// SetUnallocated sets the value of Unallocated.
+func (s *RangeBreakdown) SetUnallocated(val UnallocatedShare) {
+	s.Unallocated = val
+}
+
+// Ref: #/components/schemas/SourceInfo
+type SourceInfo struct {
+	Name        Provenance `json:"name"`
+	Type        string     `json:"type"`
+	BuildTime   time.Time  `json:"buildTime"`
+	Description OptString  `json:"description"`
+	// Количество подсетей, вошедших в набор из этого
+	// источника.
+	Networks int64 `json:"networks"`
+}
+
+// GetName returns the value of Name.
+func (s *SourceInfo) GetName() Provenance {
+	return s.Name
+}
+
+// GetType returns the value of Type.
+func (s *SourceInfo) GetType() string {
+	return s.Type
+}
+
+// GetBuildTime returns the value of BuildTime.
+func (s *SourceInfo) GetBuildTime() time.Time {
+	return s.BuildTime
+}
+
+// GetDescription returns the value of Description.
+func (s *SourceInfo) GetDescription() OptString {
+	return s.Description
+}
+
+// GetNetworks returns the value of Networks.
+func (s *SourceInfo) GetNetworks() int64 {
+	return s.Networks
+}
+
+// SetName sets the value of Name.
+func (s *SourceInfo) SetName(val Provenance) {
+	s.Name = val
+}
+
+// SetType sets the value of Type.
+func (s *SourceInfo) SetType(val string) {
+	s.Type = val
+}
+
+// SetBuildTime sets the value of BuildTime.
+func (s *SourceInfo) SetBuildTime(val time.Time) {
+	s.BuildTime = val
+}
+
+// SetDescription sets the value of Description.
+func (s *SourceInfo) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetNetworks sets the value of Networks.
+func (s *SourceInfo) SetNetworks(val int64) {
+	s.Networks = val
+}
+
+// Запись реестра IANA, в которую попал адрес (отсутствует
+// для обычных адресов).
+// Ref: #/components/schemas/SpecialBlock
+type SpecialBlock struct {
+	Network Cidr   `json:"network"`
+	Name    string `json:"name"`
+	Rfc     string `json:"rfc"`
+}
+
+// GetNetwork returns the value of Network.
+func (s *SpecialBlock) GetNetwork() Cidr {
+	return s.Network
+}
+
+// GetName returns the value of Name.
+func (s *SpecialBlock) GetName() string {
+	return s.Name
+}
+
+// GetRfc returns the value of Rfc.
+func (s *SpecialBlock) GetRfc() string {
+	return s.Rfc
+}
+
+// SetNetwork sets the value of Network.
+func (s *SpecialBlock) SetNetwork(val Cidr) {
+	s.Network = val
+}
+
+// SetName sets the value of Name.
+func (s *SpecialBlock) SetName(val string) {
+	s.Name = val
+}
+
+// SetRfc sets the value of Rfc.
+func (s *SpecialBlock) SetRfc(val string) {
+	s.Rfc = val
+}
+
+// Ref: #/components/schemas/UnallocatedShare
+type UnallocatedShare struct {
+	// Участки диапазона, не покрытые набором данных.
+	Ranges    []string     `json:"ranges"`
+	Addresses AddressCount `json:"addresses"`
+	// Доля диапазона, %.
+	Percent float64 `json:"percent"`
+}
+
+// GetRanges returns the value of Ranges.
+func (s *UnallocatedShare) GetRanges() []string {
+	return s.Ranges
+}
+
+// GetAddresses returns the value of Addresses.
+func (s *UnallocatedShare) GetAddresses() AddressCount {
+	return s.Addresses
+}
+
+// GetPercent returns the value of Percent.
+func (s *UnallocatedShare) GetPercent() float64 {
+	return s.Percent
+}
+
+// SetRanges sets the value of Ranges.
+func (s *UnallocatedShare) SetRanges(val []string) {
+	s.Ranges = val
+}
+
+// SetAddresses sets the value of Addresses.
+func (s *UnallocatedShare) SetAddresses(val AddressCount) {
+	s.Addresses = val
+}
+
+// SetPercent sets the value of Percent.
+func (s *UnallocatedShare) SetPercent(val float64) {
+	s.Percent = val
+}
@@ -8,12 +8,55 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// EvaluateExpression implements evaluateExpression operation.
+	//
+	// Выражение строится из ISO кодов стран, групп (см. /geo/groups)
+	// и CIDR:
+	// "+" или "|" объединение, "&" пересечение, "-" разность,
+	// скобки для группировки.
+	// "&" выполняется раньше "+" и "-". Пример: "EU + CH - (185.0.0.0/16 +
+	// 2001:db8::/32)".
+	// Результат возвращается минимальным списком CIDR в JSON
+	// или в формате экспорта.
+	//
+	// GET /geo/expression
+	EvaluateExpression(ctx context.Context, params EvaluateExpressionParams) (EvaluateExpressionRes, error)
+	// ExplainIp implements explainIp operation.
+	//
+	// Подробный разбор определения страны по ip адресу.
+	//
+	// GET /geo/explain
+	ExplainIp(ctx context.Context, params ExplainIpParams) (ExplainIpRes, error)
+	// GetBogons implements getBogons operation.
+	//
+	// Список bogon-сетей (не глобально достижимых по реестрам
+	// IANA) для фильтрации.
+	//
+	// GET /geo/bogons
+	GetBogons(ctx context.Context, params GetBogonsParams) (GetBogonsRes, error)
+	// GetCidrData implements getCidrData operation.
+	//
+	// Для каждого CIDR возвращает точное совпадение (если
+	// такая подсеть есть в наборе),
+	// все подсети набора, которые его содержат, и
+	// постранично все подсети, которые
+	// содержатся в нём, с кодами стран.
+	//
+	// POST /geo/cidr_data
+	GetCidrData(ctx context.Context, req *CidrPayload) (GetCidrDataRes, error)
 	// GetCountries implements getCountries operation.
 	//
 	// Получение полного перечня кодов стран.
 	//
 	// GET /geo/countries
 	GetCountries(ctx context.Context) (GetCountriesRes, error)
+	// GetCountry implements getCountry operation.
+	//
+	// Справочные данные ISO 3166 по стране и её доля в наборе
+	// данных.
+	//
+	// GET /geo/countries/{code}
+	GetCountry(ctx context.Context, params GetCountryParams) (GetCountryRes, error)
 	// GetCountryNetworks implements getCountryNetworks operation.
 	//
 	// Получение полного перечня подсетей по коду страны.
@@ -27,6 +70,20 @@ type Handler interface {
 	//
 	// GET /geo/networks/paged
 	GetCountryNetworksPaged(ctx context.Context, params GetCountryNetworksPagedParams) (GetCountryNetworksPagedRes, error)
+	// GetDataset implements getDataset operation.
+	//
+	// Метаданные загруженного набора данных и его
+	// источников.
+	//
+	// GET /geo/dataset
+	GetDataset(ctx context.Context) (GetDatasetRes, error)
+	// GetGroups implements getGroups operation.
+	//
+	// Каталог групп стран (континенты, союзы,
+	// пользовательские группы).
+	//
+	// GET /geo/groups
+	GetGroups(ctx context.Context) (GetGroupsRes, error)
 	// GetHealth implements getHealth operation.
 	//
 	// Get service health.
@@ -39,6 +96,30 @@ type Handler interface {
 	//
 	// POST /geo/ip_data
 	GetIpData(ctx context.Context, req *GeoPayload) (GetIpDataRes, error)
+	// GetQualityReport implements getQualityReport operation.
+	//
+	// Подсети, страна которых отличается от registered_country;
+	// подсети с кодом по умолчанию (ZZ);
+	// IPv6 подсети алиасов IPv4 (::ffff:0:0/96, 2002::/16, 2001::/32), код
+	// которых расходится с IPv4 подсетью
+	// (встречаются, только если алиасы не пропускаются при
+	// загрузке); страны, число подсетей или адресов
+	// которых резко изменилось относительно предыдущей
+	// сборки снапшота.
+	//
+	// GET /geo/quality
+	GetQualityReport(ctx context.Context, params GetQualityReportParams) (GetQualityReportRes, error)
+	// GetRangeBreakdown implements getRangeBreakdown operation.
+	//
+	// Принимает CIDR, диапазоны вида "185.0.0.0-185.3.255.255" и
+	// отдельные адреса.
+	// Для каждого диапазона возвращает страны с
+	// пересекающимися подсетями набора,
+	// точным количеством адресов и долей диапазона, а также
+	// непокрытые набором участки.
+	//
+	// POST /geo/breakdown
+	GetRangeBreakdown(ctx context.Context, req *BreakdownPayload) (GetRangeBreakdownRes, error)
 	// NewError creates *DefaultErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -13,6 +13,54 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// EvaluateExpression implements evaluateExpression operation.
+//
+// Выражение строится из ISO кодов стран, групп (см. /geo/groups)
+// и CIDR:
+// "+" или "|" объединение, "&" пересечение, "-" разность,
+// скобки для группировки.
+// "&" выполняется раньше "+" и "-". Пример: "EU + CH - (185.0.0.0/16 +
+// 2001:db8::/32)".
+// Результат возвращается минимальным списком CIDR в JSON
+// или в формате экспорта.
+//
+// GET /geo/expression
+func (UnimplementedHandler) EvaluateExpression(ctx context.Context, params EvaluateExpressionParams) (r EvaluateExpressionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ExplainIp implements explainIp operation.
+//
+// Подробный разбор определения страны по ip адресу.
+//
+// GET /geo/explain
+func (UnimplementedHandler) ExplainIp(ctx context.Context, params ExplainIpParams) (r ExplainIpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetBogons implements getBogons operation.
+//
+// Список bogon-сетей (не глобально достижимых по реестрам
+// IANA) для фильтрации.
+//
+// GET /geo/bogons
+func (UnimplementedHandler) GetBogons(ctx context.Context, params GetBogonsParams) (r GetBogonsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetCidrData implements getCidrData operation.
+//
+// Для каждого CIDR возвращает точное совпадение (если
+// такая подсеть есть в наборе),
+// все подсети набора, которые его содержат, и
+// постранично все подсети, которые
+// содержатся в нём, с кодами стран.
+//
+// POST /geo/cidr_data
+func (UnimplementedHandler) GetCidrData(ctx context.Context, req *CidrPayload) (r GetCidrDataRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCountries implements getCountries operation.
 //
 // Получение полного перечня кодов стран.
@@ -22,6 +70,16 @@ func (UnimplementedHandler) GetCountries(ctx context.Context) (r GetCountriesRes
 	return r, ht.ErrNotImplemented
 }
 
+// GetCountry implements getCountry operation.
+//
+// Справочные данные ISO 3166 по стране и её доля в наборе
+// данных.
+//
+// GET /geo/countries/{code}
+func (UnimplementedHandler) GetCountry(ctx context.Context, params GetCountryParams) (r GetCountryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetCountryNetworks implements getCountryNetworks operation.
 //
 // Получение полного перечня подсетей по коду страны.
@@ -41,6 +99,26 @@ func (UnimplementedHandler) GetCountryNetworksPaged(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// GetDataset implements getDataset operation.
+//
+// Метаданные загруженного набора данных и его
+// источников.
+//
+// GET /geo/dataset
+func (UnimplementedHandler) GetDataset(ctx context.Context) (r GetDatasetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetGroups implements getGroups operation.
+//
+// Каталог групп стран (континенты, союзы,
+// пользовательские группы).
+//
+// GET /geo/groups
+func (UnimplementedHandler) GetGroups(ctx context.Context) (r GetGroupsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetHealth implements getHealth operation.
 //
 // Get service health.
@@ -59,6 +137,36 @@ func (UnimplementedHandler) GetIpData(ctx context.Context, req *GeoPayload) (r G
 	return r, ht.ErrNotImplemented
 }
 
+// GetQualityReport implements getQualityReport operation.
+//
+// Подсети, страна которых отличается от registered_country;
+// подсети с кодом по умолчанию (ZZ);
+// IPv6 подсети алиасов IPv4 (::ffff:0:0/96, 2002::/16, 2001::/32), код
+// которых расходится с IPv4 подсетью
+// (встречаются, только если алиасы не пропускаются при
+// загрузке); страны, число подсетей или адресов
+// которых резко изменилось относительно предыдущей
+// сборки снапшота.
+//
+// GET /geo/quality
+func (UnimplementedHandler) GetQualityReport(ctx context.Context, params GetQualityReportParams) (r GetQualityReportRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRangeBreakdown implements getRangeBreakdown operation.
+//
+// Принимает CIDR, диапазоны вида "185.0.0.0-185.3.255.255" и
+// отдельные адреса.
+// Для каждого диапазона возвращает страны с
+// пересекающимися подсетями набора,
+// точным количеством адресов и долей диапазона, а также
+// непокрытые набором участки.
+//
+// POST /geo/breakdown
+func (UnimplementedHandler) GetRangeBreakdown(ctx context.Context, req *BreakdownPayload) (r GetRangeBreakdownRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *DefaultErrorStatusCode from error returned by handler.
 //
 // Used for common default response.